@@ -2,20 +2,37 @@ package main
 
 import (
 	"context"
+	"crypto/rsa"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"time"
 
+	"connectrpc.com/connect"
+	connectcors "connectrpc.com/cors"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/cors"
 	"github.com/sikigasa/task-controller/cmd/config"
+	"github.com/sikigasa/task-controller/docs"
+	"github.com/sikigasa/task-controller/internal/auth"
 	"github.com/sikigasa/task-controller/internal/infra"
 	postgres "github.com/sikigasa/task-controller/internal/infra/driver"
+	"github.com/sikigasa/task-controller/internal/interceptor"
 	"github.com/sikigasa/task-controller/internal/usecase"
 	task "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func init() {
@@ -24,7 +41,7 @@ func init() {
 
 func main() {
 	// 8080番portのListenerを作成
-	port := 8080
+	port := config.Config.Server.Port
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		panic(err)
@@ -43,22 +60,258 @@ func main() {
 	}
 	defer conn.Close(ctx)
 
-	// gRPCサーバーを作成
-	s := grpc.NewServer()
-	task.RegisterTaskServiceServer(s, usecase.NewTaskService(infra.NewTaskRepo(db), infra.NewTagRepo(db), infra.NewTaskTagRepo(db), postgres.NewPostgresTransaction(db)))
-	task.RegisterTagServiceServer(s, usecase.NewTagService(infra.NewTagRepo(db)))
+	// 他のレプリカでの変更もWatchTasksで配信するため、DBの通知を購読する
+	notifyListener := conn.Listener()
+	taskWatcher, err := infra.NewTaskWatcher(notifyListener)
+	if err != nil {
+		panic(err)
+	}
+
+	// アクセストークンとAPIキーのどちらでも認証できるようにする
+	tokens, err := newTokenManager(config.Config.Auth)
+	if err != nil {
+		log.Fatalf("failed to load JWT keys: %v", err)
+	}
+	apiKeyRepo := infra.NewAPIKeyRepo(db)
+	authInterceptor := interceptor.NewAuthInterceptor(auth.NewAuthenticator(tokens, apiKeyRepo),
+		v1connect.AuthServiceSignupProcedure,
+		v1connect.AuthServiceLoginProcedure,
+		grpcreflect.ReflectV1ServiceName,
+		grpcreflect.ReflectV1AlphaServiceName,
+		grpchealth.HealthV1ServiceName,
+	)
+
+	// Connect/gRPC/gRPC-Webの3プロトコルを受け付けるハンドラーを作成
+	validateInterceptor, err := interceptor.NewValidateInterceptor()
+	if err != nil {
+		panic(err)
+	}
+	interceptors := connect.WithInterceptors(interceptor.NewErrorInterceptor(), authInterceptor, validateInterceptor)
+
+	projectRepo := infra.NewProjectRepo(db)
+	workflowRepo := infra.NewWorkflowRepo(db)
+	attachmentRepo := infra.NewAttachmentRepo(db)
+	storage, err := newObjectStorage(config.Config.R2)
+	if err != nil {
+		log.Fatalf("failed to create object storage: %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewTaskServiceHandler(usecase.NewTaskService(infra.NewTaskRepo(db), infra.NewTagRepo(db), infra.NewTaskTagRepo(db), projectRepo, infra.NewTaskDependencyRepo(db), workflowRepo, infra.NewTaskHistoryRepo(db), taskWatcher, postgres.NewPostgresTransaction(db)), interceptors))
+	mux.Handle(v1connect.NewTagServiceHandler(usecase.NewTagService(infra.NewTagRepo(db), projectRepo), interceptors))
+	mux.Handle(v1connect.NewAuthServiceHandler(usecase.NewAuthService(infra.NewUserRepo(db), apiKeyRepo, tokens, config.Config.Auth.BootstrapOwnerEmail), interceptors))
+	mux.Handle(v1connect.NewProjectServiceHandler(usecase.NewProjectService(projectRepo, infra.NewProjectInvitationRepo(db), infra.NewUserRepo(db), workflowRepo, infra.NewTaskRepo(db), infra.NewTaskTagRepo(db), infra.NewTaskHistoryRepo(db), postgres.NewPostgresTransaction(db)), interceptors))
+	mux.Handle(v1connect.NewCommentServiceHandler(usecase.NewCommentService(infra.NewCommentRepo(db), infra.NewTaskRepo(db), projectRepo), interceptors))
+	mux.Handle(v1connect.NewAttachmentServiceHandler(usecase.NewAttachmentService(attachmentRepo, infra.NewTaskRepo(db), projectRepo, storage), interceptors))
+
+	// 所有者のいない既存のデータを登録済みのユーザーに引き継ぐ。未登録ならサインアップ時に引き継ぐ
+	if email := config.Config.Auth.BootstrapOwnerEmail; email != "" {
+		if err := usecase.ClaimOwnerlessData(context.Background(), infra.NewUserRepo(db), email); err != nil {
+			log.Fatalf("failed to claim data without an owner: %v", err)
+		}
+	}
+
+	// バックグラウンドのジョブはシャットダウン時に止める
+	jobCtx, jobCancel := context.WithCancel(context.Background())
+	defer jobCancel()
+
+	// 期限が近いタスクと期限切れのタスクを所有者に知らせる
+	if config.Config.Reminder.Interval > 0 {
+		notifier, err := newNotifier(config.Config.Reminder)
+		if err != nil {
+			log.Fatalf("failed to create notifier: %v", err)
+		}
+		windows, err := reminderWindows(config.Config.Reminder)
+		if err != nil {
+			log.Fatalf("invalid reminder windows: %v", err)
+		}
+		scheduler := usecase.NewReminderScheduler(infra.NewReminderRepo(db), notifier, postgres.NewPostgresTransaction(db), windows)
+		go scheduler.Run(jobCtx, config.Config.Reminder.Interval)
+	}
+
+	// 削除された添付ファイルのオブジェクトをストレージから消す
+	if storage != nil {
+		cleaner := usecase.NewAttachmentCleaner(attachmentRepo, storage, postgres.NewPostgresTransaction(db))
+		go cleaner.Run(jobCtx, config.Config.R2.CleanupInterval)
+	}
+
+	// 保持期間を過ぎたゴミ箱のタスクを完全に削除する
+	if config.Config.Trash.PurgeInterval > 0 {
+		purger := usecase.NewTrashPurger(infra.NewTaskRepo(db), config.Config.Trash.Retention)
+		go purger.Run(jobCtx, config.Config.Trash.PurgeInterval)
+	}
+
+	reflector := grpcreflect.NewStaticReflector(v1connect.TaskServiceName, v1connect.TagServiceName, v1connect.AuthServiceName, v1connect.ProjectServiceName, v1connect.CommentServiceName, v1connect.AttachmentServiceName)
+	mux.Handle(grpcreflect.NewHandlerV1(reflector, interceptors))
+	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector, interceptors))
+	mux.Handle(grpchealth.NewHandler(grpchealth.NewStaticChecker(v1connect.TaskServiceName, v1connect.TagServiceName, v1connect.AuthServiceName, v1connect.ProjectServiceName, v1connect.CommentServiceName, v1connect.AttachmentServiceName), interceptors))
+
+	// 同じポートのgRPCへ中継するREST/JSONゲートウェイを作成
+	gwCtx, gwCancel := context.WithCancel(context.Background())
+	defer gwCancel()
+
+	gwMux := runtime.NewServeMux()
+	endpoint := fmt.Sprintf("localhost:%d", port)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := task.RegisterTaskServiceHandlerFromEndpoint(gwCtx, gwMux, endpoint, opts); err != nil {
+		panic(err)
+	}
+	if err := task.RegisterTagServiceHandlerFromEndpoint(gwCtx, gwMux, endpoint, opts); err != nil {
+		panic(err)
+	}
+	if err := task.RegisterAuthServiceHandlerFromEndpoint(gwCtx, gwMux, endpoint, opts); err != nil {
+		panic(err)
+	}
+	if err := task.RegisterProjectServiceHandlerFromEndpoint(gwCtx, gwMux, endpoint, opts); err != nil {
+		panic(err)
+	}
+	if err := task.RegisterCommentServiceHandlerFromEndpoint(gwCtx, gwMux, endpoint, opts); err != nil {
+		panic(err)
+	}
+	if err := task.RegisterAttachmentServiceHandlerFromEndpoint(gwCtx, gwMux, endpoint, opts); err != nil {
+		panic(err)
+	}
+	mux.Handle("/v1/", gwMux)
+	mux.HandleFunc("GET /swagger.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(docs.OpenAPI)
+	})
 
-	reflection.Register(s)
-	// 作成したgRPCサーバーを、8080番ポートで稼働させる
+	// ブラウザ(frontend)から直接呼び出せるようにCORSを許可する
+	handler := cors.New(cors.Options{
+		AllowedOrigins: config.Config.Server.AllowedOrigins,
+		AllowedMethods: append(connectcors.AllowedMethods(), http.MethodPatch, http.MethodDelete),
+		AllowedHeaders: append(connectcors.AllowedHeaders(), "Authorization"),
+		ExposedHeaders: connectcors.ExposedHeaders(),
+	}).Handler(mux)
+
+	// gRPCはHTTP/2必須のため、TLSなしのHTTP/2(h2c)も受け付ける
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	s := &http.Server{
+		Handler:   handler,
+		Protocols: protocols,
+	}
+
+	// 作成したサーバーを、8080番ポートで稼働させる
 	go func() {
-		log.Printf("start gRPC server port: %v", port)
-		s.Serve(listener)
+		log.Printf("start server port: %v", port)
+		if err := s.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Printf("server error: %v", err)
+		}
 	}()
 
 	// Ctrl+Cが入力されたらGraceful shutdownされるようにする
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
-	log.Println("stopping gRPC server...")
-	s.GracefulStop()
+	log.Println("stopping server...")
+	// 接続中のWatchTasksストリームを終了させ、クライアントに再接続させる
+	if err := notifyListener.Close(); err != nil {
+		log.Printf("listener close error: %v", err)
+	}
+	jobCancel()
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	if err := s.Shutdown(shutdownCtx); err != nil {
+		log.Printf("server shutdown error: %v", err)
+	}
+}
+
+func newTokenManager(cfg config.Auth) (*auth.TokenManager, error) {
+	switch cfg.JWTAlgorithm {
+	case "HS256":
+		if cfg.JWTSecret == "" {
+			return nil, errors.New("JWT_SECRET is required for HS256")
+		}
+		return auth.NewHS256TokenManager([]byte(cfg.JWTSecret), cfg.TokenTTL), nil
+	case "RS256":
+		// 秘密鍵がなければトークンの検証のみ行う
+		var privateKey *rsa.PrivateKey
+		if cfg.JWTPrivateKeyFile != "" {
+			pem, err := os.ReadFile(cfg.JWTPrivateKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			if privateKey, err = jwt.ParseRSAPrivateKeyFromPEM(pem); err != nil {
+				return nil, err
+			}
+		}
+		var publicKey *rsa.PublicKey
+		if cfg.JWTPublicKeyFile != "" {
+			pem, err := os.ReadFile(cfg.JWTPublicKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			if publicKey, err = jwt.ParseRSAPublicKeyFromPEM(pem); err != nil {
+				return nil, err
+			}
+		} else if privateKey != nil {
+			publicKey = &privateKey.PublicKey
+		}
+		if publicKey == nil {
+			return nil, errors.New("JWT_PUBLIC_KEY_FILE or JWT_PRIVATE_KEY_FILE is required for RS256")
+		}
+		return auth.NewRS256TokenManager(privateKey, publicKey, cfg.TokenTTL), nil
+	}
+	return nil, fmt.Errorf("unsupported JWT_ALGORITHM %q", cfg.JWTAlgorithm)
+}
+
+func newNotifier(cfg config.Reminder) (infra.Notifier, error) {
+	switch cfg.Notifier {
+	case "log":
+		return infra.NewLogNotifier(), nil
+	case "smtp":
+		if cfg.SMTPHost == "" || cfg.SMTPFrom == "" {
+			return nil, errors.New("SMTP_HOST and SMTP_FROM are required for smtp")
+		}
+		return infra.NewSMTPNotifier(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom, 10*time.Second), nil
+	case "webhook":
+		if cfg.WebhookURL == "" {
+			return nil, errors.New("REMINDER_WEBHOOK_URL is required for webhook")
+		}
+		return infra.NewWebhookNotifier(cfg.WebhookURL, &http.Client{Timeout: 10 * time.Second}), nil
+	}
+	return nil, fmt.Errorf("unknown REMINDER_NOTIFIER %q", cfg.Notifier)
+}
+
+// reminderWindows returns the windows of cfg, with zero standing for the
+// overdue reminder.
+func reminderWindows(cfg config.Reminder) ([]time.Duration, error) {
+	var windows []time.Duration
+	for _, w := range cfg.Windows {
+		if w < time.Second {
+			return nil, fmt.Errorf("REMINDER_WINDOWS must be at least 1s, got %s", w)
+		}
+		windows = append(windows, w)
+	}
+	if cfg.Overdue {
+		windows = append(windows, 0)
+	}
+	return windows, nil
+}
+
+// newObjectStorage returns the storage for attachments, or nil when no bucket
+// is configured.
+func newObjectStorage(cfg config.R2) (infra.ObjectStorage, error) {
+	if cfg.Bucket == "" {
+		return nil, nil
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		if cfg.AccountID == "" {
+			return nil, errors.New("R2_ENDPOINT or ACCOUNT_ID is required for R2_BUCKET")
+		}
+		endpoint = fmt.Sprintf("https://%s.r2.cloudflarestorage.com", cfg.AccountID)
+	}
+	client := s3.New(s3.Options{
+		Region:       cfg.Region,
+		BaseEndpoint: aws.String(endpoint),
+		Credentials:  credentials.NewStaticCredentialsProvider(cfg.AccessKey, cfg.SecretAccessKey, ""),
+		UsePathStyle: cfg.UsePathStyle,
+		// S3互換のストレージは新しいチェックサムに対応していないことがあるため、必要な場合のみ付ける
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenRequired,
+	})
+	return infra.NewS3Storage(client, cfg.Bucket, cfg.PresignTTL), nil
 }
@@ -17,6 +17,14 @@ func LoadEnv(path ...string) {
 		log.Println("load .env file")
 	}
 
+	if err := env.Parse(&config.Server); err != nil {
+		log.Fatalf("env load error: %v", err)
+	}
+
+	if err := env.Parse(&config.Auth); err != nil {
+		log.Fatalf("env load error: %v", err)
+	}
+
 	if err := env.Parse(&config.R2); err != nil {
 		log.Fatalf("env load error: %v", err)
 	}
@@ -25,5 +33,13 @@ func LoadEnv(path ...string) {
 		log.Fatalf("env load error: %v", err)
 	}
 
+	if err := env.Parse(&config.Reminder); err != nil {
+		log.Fatalf("env load error: %v", err)
+	}
+
+	if err := env.Parse(&config.Trash); err != nil {
+		log.Fatalf("env load error: %v", err)
+	}
+
 	Config = config
 }
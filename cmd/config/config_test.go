@@ -0,0 +1,30 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadEnv(t *testing.T) {
+	t.Run("正常系_ゴミ箱の既定値", func(t *testing.T) {
+		LoadEnv()
+		if Config.Trash.Retention != 720*time.Hour {
+			t.Errorf("expected retention of 720h, got %v", Config.Trash.Retention)
+		}
+		if Config.Trash.PurgeInterval != time.Hour {
+			t.Errorf("expected purge interval of 1h, got %v", Config.Trash.PurgeInterval)
+		}
+	})
+
+	t.Run("正常系_環境変数でゴミ箱の設定を変更", func(t *testing.T) {
+		t.Setenv("TRASH_RETENTION", "48h")
+		t.Setenv("TRASH_PURGE_INTERVAL", "0s")
+		LoadEnv()
+		if Config.Trash.Retention != 48*time.Hour {
+			t.Errorf("expected retention of 48h, got %v", Config.Trash.Retention)
+		}
+		if Config.Trash.PurgeInterval != 0 {
+			t.Errorf("expected purging to be disabled, got %v", Config.Trash.PurgeInterval)
+		}
+	})
+}
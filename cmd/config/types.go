@@ -1,10 +1,34 @@
 package config
 
+import "time"
+
 var Config = &config{}
 
 type config struct {
+	Server   Server
+	Auth     Auth
 	R2       R2
 	Postgres Postgres
+	Reminder Reminder
+	Trash    Trash
+}
+
+type Server struct {
+	Port           int      `env:"SERVER_PORT" envDefault:"8080"`
+	AllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS" envSeparator:"," envDefault:"http://localhost:5173"`
+}
+
+type Auth struct {
+	// JWTAlgorithm is HS256 (signed with JWTSecret) or RS256 (signed with the
+	// PEM encoded key pair in the key files).
+	JWTAlgorithm      string        `env:"JWT_ALGORITHM" envDefault:"HS256"`
+	JWTSecret         string        `env:"JWT_SECRET"`
+	JWTPrivateKeyFile string        `env:"JWT_PRIVATE_KEY_FILE"`
+	JWTPublicKeyFile  string        `env:"JWT_PUBLIC_KEY_FILE"`
+	TokenTTL          time.Duration `env:"JWT_TOKEN_TTL" envDefault:"24h"`
+	// BootstrapOwnerEmail is the user that takes over the tasks and tags
+	// created before user accounts existed.
+	BootstrapOwnerEmail string `env:"BOOTSTRAP_OWNER_EMAIL"`
 }
 
 type R2 struct {
@@ -12,6 +36,41 @@ type R2 struct {
 	SecretAccessKey string `env:"AWS_SECRET_ACCESS_KEY"`
 
 	AccountID string `env:"ACCOUNT_ID"`
+	// Endpoint defaults to the R2 endpoint of AccountID. Set it to use MinIO
+	// or another S3 compatible storage instead.
+	Endpoint     string `env:"R2_ENDPOINT"`
+	Region       string `env:"R2_REGION" envDefault:"auto"`
+	UsePathStyle bool   `env:"R2_USE_PATH_STYLE"`
+	// Bucket stores the attachments. Attachments are disabled when empty.
+	Bucket          string        `env:"R2_BUCKET"`
+	PresignTTL      time.Duration `env:"R2_PRESIGN_TTL" envDefault:"15m"`
+	CleanupInterval time.Duration `env:"R2_CLEANUP_INTERVAL" envDefault:"1m"`
+}
+
+type Reminder struct {
+	// Interval is how often due reminders are looked for. Zero disables
+	// reminders.
+	Interval time.Duration `env:"REMINDER_INTERVAL" envDefault:"1m"`
+	// Windows are how long before limited_at a reminder is sent. Overdue
+	// tasks get one more reminder when Overdue is set.
+	Windows []time.Duration `env:"REMINDER_WINDOWS" envSeparator:"," envDefault:"24h,1h"`
+	Overdue bool            `env:"REMINDER_OVERDUE" envDefault:"true"`
+	// Notifier is log, smtp or webhook.
+	Notifier     string `env:"REMINDER_NOTIFIER" envDefault:"log"`
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     int    `env:"SMTP_PORT" envDefault:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	SMTPFrom     string `env:"SMTP_FROM"`
+	WebhookURL   string `env:"REMINDER_WEBHOOK_URL"`
+}
+
+type Trash struct {
+	// Retention is how long deleted tasks stay in the trash.
+	Retention time.Duration `env:"TRASH_RETENTION" envDefault:"720h"`
+	// PurgeInterval is how often expired tasks are purged. Zero keeps deleted
+	// tasks in the trash forever.
+	PurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" envDefault:"1h"`
 }
 
 type Postgres struct {
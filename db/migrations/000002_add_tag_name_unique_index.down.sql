@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "tag_name_lower_key";
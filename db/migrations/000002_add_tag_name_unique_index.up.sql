@@ -0,0 +1,21 @@
+-- 大文字小文字だけが異なるタグは最も小さいIDのタグにまとめる
+INSERT INTO "task_tag" (task_id, tag_id)
+SELECT DISTINCT task_tag.task_id, dup.keep_id
+FROM "task_tag"
+  JOIN (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY id) AS keep_id
+    FROM "tag"
+  ) dup ON dup.id = task_tag.tag_id
+WHERE dup.id <> dup.keep_id
+  AND NOT EXISTS (
+    SELECT 1 FROM "task_tag" kept
+    WHERE kept.task_id = task_tag.task_id AND kept.tag_id = dup.keep_id
+  );
+DELETE FROM "tag"
+USING (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY id) AS keep_id
+    FROM "tag"
+  ) dup
+WHERE tag.id = dup.id
+  AND dup.id <> dup.keep_id;
+CREATE UNIQUE INDEX "tag_name_lower_key" ON "tag" (lower(name));
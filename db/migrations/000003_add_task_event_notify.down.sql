@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS notify_task_tag_event ON "task_tag";
+DROP FUNCTION IF EXISTS notify_task_tag_event();
+DROP TRIGGER IF EXISTS notify_task_event ON "task";
+DROP FUNCTION IF EXISTS notify_task_event();
@@ -0,0 +1,46 @@
+CREATE OR REPLACE FUNCTION notify_task_event() RETURNS TRIGGER AS $$ BEGIN IF TG_OP = 'INSERT' THEN PERFORM pg_notify(
+    'task_events',
+    json_build_object('type', 'created', 'task_id', NEW.id)::text
+  );
+ELSIF TG_OP = 'UPDATE' THEN PERFORM pg_notify(
+  'task_events',
+  json_build_object('type', 'updated', 'task_id', NEW.id)::text
+);
+ELSE PERFORM pg_notify(
+  'task_events',
+  json_build_object('type', 'deleted', 'task_id', OLD.id)::text
+);
+END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER notify_task_event
+AFTER
+INSERT
+  OR
+UPDATE
+  OR DELETE ON "task" FOR EACH ROW EXECUTE FUNCTION notify_task_event();
+-- タグの付け外しはタスクの更新として通知する
+CREATE OR REPLACE FUNCTION notify_task_tag_event() RETURNS TRIGGER AS $$
+DECLARE changed_task_id VARCHAR;
+BEGIN IF TG_OP = 'INSERT' THEN changed_task_id := NEW.task_id;
+ELSE changed_task_id := OLD.task_id;
+END IF;
+-- タスク削除に伴うカスケード削除では通知しない
+IF NOT EXISTS (
+  SELECT 1
+  FROM "task"
+  WHERE id = changed_task_id
+) THEN RETURN NULL;
+END IF;
+PERFORM pg_notify(
+  'task_events',
+  json_build_object('type', 'updated', 'task_id', changed_task_id)::text
+);
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER notify_task_tag_event
+AFTER
+INSERT
+  OR DELETE ON "task_tag" FOR EACH ROW EXECUTE FUNCTION notify_task_tag_event();
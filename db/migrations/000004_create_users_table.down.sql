@@ -0,0 +1,61 @@
+CREATE OR REPLACE FUNCTION notify_task_event() RETURNS TRIGGER AS $$ BEGIN IF TG_OP = 'INSERT' THEN PERFORM pg_notify(
+    'task_events',
+    json_build_object('type', 'created', 'task_id', NEW.id)::text
+  );
+ELSIF TG_OP = 'UPDATE' THEN PERFORM pg_notify(
+  'task_events',
+  json_build_object('type', 'updated', 'task_id', NEW.id)::text
+);
+ELSE PERFORM pg_notify(
+  'task_events',
+  json_build_object('type', 'deleted', 'task_id', OLD.id)::text
+);
+END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE OR REPLACE FUNCTION notify_task_tag_event() RETURNS TRIGGER AS $$
+DECLARE changed_task_id VARCHAR;
+BEGIN IF TG_OP = 'INSERT' THEN changed_task_id := NEW.task_id;
+ELSE changed_task_id := OLD.task_id;
+END IF;
+-- タスク削除に伴うカスケード削除では通知しない
+IF NOT EXISTS (
+  SELECT 1
+  FROM "task"
+  WHERE id = changed_task_id
+) THEN RETURN NULL;
+END IF;
+PERFORM pg_notify(
+  'task_events',
+  json_build_object('type', 'updated', 'task_id', changed_task_id)::text
+);
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+DROP INDEX IF EXISTS "tag_owner_id_name_lower_key";
+-- ユーザーごとに作られた同じ名前のタグは最も小さいIDのタグにまとめる
+INSERT INTO "task_tag" (task_id, tag_id)
+SELECT DISTINCT task_tag.task_id, dup.keep_id
+FROM "task_tag"
+  JOIN (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY id) AS keep_id
+    FROM "tag"
+  ) dup ON dup.id = task_tag.tag_id
+WHERE dup.id <> dup.keep_id
+  AND NOT EXISTS (
+    SELECT 1 FROM "task_tag" kept
+    WHERE kept.task_id = task_tag.task_id AND kept.tag_id = dup.keep_id
+  );
+DELETE FROM "tag"
+USING (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY id) AS keep_id
+    FROM "tag"
+  ) dup
+WHERE tag.id = dup.id
+  AND dup.id <> dup.keep_id;
+CREATE UNIQUE INDEX "tag_name_lower_key" ON "tag" (lower(name));
+DROP INDEX IF EXISTS "task_owner_id_idx";
+ALTER TABLE "tag" DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE "task" DROP COLUMN IF EXISTS owner_id;
+DROP TABLE IF EXISTS "users";
@@ -0,0 +1,80 @@
+CREATE TABLE "users" (
+  id VARCHAR PRIMARY KEY,
+  email VARCHAR NOT NULL,
+  password_hash VARCHAR NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX "users_email_lower_key" ON "users" (lower(email));
+-- 既存のタスクとタグは所有者なしとなり、BOOTSTRAP_OWNER_EMAILのユーザーが引き継ぐまで見えない
+ALTER TABLE "task"
+ADD COLUMN owner_id VARCHAR REFERENCES "users" (id) ON DELETE CASCADE;
+ALTER TABLE "tag"
+ADD COLUMN owner_id VARCHAR REFERENCES "users" (id) ON DELETE CASCADE;
+CREATE INDEX "task_owner_id_idx" ON "task" (owner_id);
+-- タグ名の重複はユーザーごとに判定する
+DROP INDEX "tag_name_lower_key";
+CREATE UNIQUE INDEX "tag_owner_id_name_lower_key" ON "tag" (owner_id, lower(name));
+-- 購読者が自分のタスクのイベントだけを受け取れるよう所有者を通知に含める
+CREATE OR REPLACE FUNCTION notify_task_event() RETURNS TRIGGER AS $$ BEGIN IF TG_OP = 'INSERT' THEN PERFORM pg_notify(
+    'task_events',
+    json_build_object(
+      'type',
+      'created',
+      'task_id',
+      NEW.id,
+      'owner_id',
+      NEW.owner_id
+    )::text
+  );
+ELSIF TG_OP = 'UPDATE' THEN PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    NEW.id,
+    'owner_id',
+    NEW.owner_id
+  )::text
+);
+ELSE PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'deleted',
+    'task_id',
+    OLD.id,
+    'owner_id',
+    OLD.owner_id
+  )::text
+);
+END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE OR REPLACE FUNCTION notify_task_tag_event() RETURNS TRIGGER AS $$
+DECLARE changed_task_id VARCHAR;
+changed_owner_id VARCHAR;
+BEGIN IF TG_OP = 'INSERT' THEN changed_task_id := NEW.task_id;
+ELSE changed_task_id := OLD.task_id;
+END IF;
+SELECT owner_id INTO changed_owner_id
+FROM "task"
+WHERE id = changed_task_id;
+-- タスク削除に伴うカスケード削除では通知しない
+IF NOT FOUND THEN RETURN NULL;
+END IF;
+PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    changed_task_id,
+    'owner_id',
+    changed_owner_id
+  )::text
+);
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
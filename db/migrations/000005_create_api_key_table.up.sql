@@ -0,0 +1,9 @@
+CREATE TABLE "api_key" (
+  id VARCHAR PRIMARY KEY,
+  user_id VARCHAR NOT NULL REFERENCES "users" (id) ON DELETE CASCADE,
+  name VARCHAR NOT NULL,
+  key_prefix VARCHAR NOT NULL,
+  key_hash VARCHAR NOT NULL UNIQUE,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "api_key_user_id_idx" ON "api_key" (user_id);
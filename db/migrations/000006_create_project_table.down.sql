@@ -0,0 +1,67 @@
+CREATE OR REPLACE FUNCTION notify_task_event() RETURNS TRIGGER AS $$ BEGIN IF TG_OP = 'INSERT' THEN PERFORM pg_notify(
+    'task_events',
+    json_build_object(
+      'type',
+      'created',
+      'task_id',
+      NEW.id,
+      'owner_id',
+      NEW.owner_id
+    )::text
+  );
+ELSIF TG_OP = 'UPDATE' THEN PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    NEW.id,
+    'owner_id',
+    NEW.owner_id
+  )::text
+);
+ELSE PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'deleted',
+    'task_id',
+    OLD.id,
+    'owner_id',
+    OLD.owner_id
+  )::text
+);
+END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE OR REPLACE FUNCTION notify_task_tag_event() RETURNS TRIGGER AS $$
+DECLARE changed_task_id VARCHAR;
+changed_owner_id VARCHAR;
+BEGIN IF TG_OP = 'INSERT' THEN changed_task_id := NEW.task_id;
+ELSE changed_task_id := OLD.task_id;
+END IF;
+SELECT owner_id INTO changed_owner_id
+FROM "task"
+WHERE id = changed_task_id;
+-- タスク削除に伴うカスケード削除では通知しない
+IF NOT FOUND THEN RETURN NULL;
+END IF;
+PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    changed_task_id,
+    'owner_id',
+    changed_owner_id
+  )::text
+);
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+DROP INDEX IF EXISTS "task_project_id_idx";
+ALTER TABLE "task" DROP COLUMN IF EXISTS project_id;
+DROP TABLE IF EXISTS "project_member";
+DROP TABLE IF EXISTS "project";
@@ -0,0 +1,96 @@
+CREATE TABLE "project" (
+  id VARCHAR PRIMARY KEY,
+  owner_id VARCHAR NOT NULL REFERENCES "users" (id) ON DELETE CASCADE,
+  name VARCHAR NOT NULL,
+  archived_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TRIGGER set_updated_at BEFORE
+UPDATE ON "project" FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+CREATE TABLE "project_member" (
+  project_id VARCHAR NOT NULL REFERENCES "project" (id) ON DELETE CASCADE,
+  user_id VARCHAR NOT NULL REFERENCES "users" (id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (project_id, user_id)
+);
+CREATE INDEX "project_member_user_id_idx" ON "project_member" (user_id);
+-- プロジェクトに属さないタスクは従来どおり所有者だけが見える
+ALTER TABLE "task"
+ADD COLUMN project_id VARCHAR REFERENCES "project" (id) ON DELETE CASCADE;
+CREATE INDEX "task_project_id_idx" ON "task" (project_id);
+-- プロジェクトのメンバーもイベントを受け取れるようプロジェクトを通知に含める
+CREATE OR REPLACE FUNCTION notify_task_event() RETURNS TRIGGER AS $$ BEGIN IF TG_OP = 'INSERT' THEN PERFORM pg_notify(
+    'task_events',
+    json_build_object(
+      'type',
+      'created',
+      'task_id',
+      NEW.id,
+      'owner_id',
+      NEW.owner_id,
+      'project_id',
+      NEW.project_id
+    )::text
+  );
+ELSIF TG_OP = 'UPDATE' THEN PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    NEW.id,
+    'owner_id',
+    NEW.owner_id,
+    'project_id',
+    NEW.project_id
+  )::text
+);
+ELSE PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'deleted',
+    'task_id',
+    OLD.id,
+    'owner_id',
+    OLD.owner_id,
+    'project_id',
+    OLD.project_id
+  )::text
+);
+END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE OR REPLACE FUNCTION notify_task_tag_event() RETURNS TRIGGER AS $$
+DECLARE changed_task_id VARCHAR;
+changed_owner_id VARCHAR;
+changed_project_id VARCHAR;
+BEGIN IF TG_OP = 'INSERT' THEN changed_task_id := NEW.task_id;
+ELSE changed_task_id := OLD.task_id;
+END IF;
+SELECT owner_id,
+  project_id INTO changed_owner_id,
+  changed_project_id
+FROM "task"
+WHERE id = changed_task_id;
+-- タスク削除に伴うカスケード削除では通知しない
+IF NOT FOUND THEN RETURN NULL;
+END IF;
+PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    changed_task_id,
+    'owner_id',
+    changed_owner_id,
+    'project_id',
+    changed_project_id
+  )::text
+);
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
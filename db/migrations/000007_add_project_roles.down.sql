@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS "tag_project_id_name_lower_key";
+DROP INDEX IF EXISTS "tag_owner_id_name_lower_key";
+-- プロジェクトのタグは作成者の個人のタグに戻す
+DELETE FROM "tag" t USING "tag" d
+WHERE t.project_id IS NOT NULL
+  AND t.owner_id = d.owner_id
+  AND lower(t.name) = lower(d.name)
+  AND t.id > d.id;
+ALTER TABLE "tag" DROP COLUMN IF EXISTS project_id;
+CREATE UNIQUE INDEX "tag_owner_id_name_lower_key" ON "tag" (owner_id, lower(name));
+DROP TABLE IF EXISTS "project_invitation";
+ALTER TABLE "project_member" DROP COLUMN IF EXISTS role;
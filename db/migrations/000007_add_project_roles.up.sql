@@ -0,0 +1,32 @@
+ALTER TABLE "project_member"
+ADD COLUMN role VARCHAR NOT NULL DEFAULT 'viewer';
+-- これまでのメンバーはすべての操作ができたため編集者とする
+UPDATE "project_member"
+SET role = 'editor';
+UPDATE "project_member" m
+SET role = 'owner'
+FROM "project" p
+WHERE p.id = m.project_id
+  AND p.owner_id = m.user_id;
+ALTER TABLE "project_member"
+ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE "project_member"
+ADD CONSTRAINT "project_member_role_check" CHECK (role IN ('owner', 'editor', 'viewer'));
+CREATE TABLE "project_invitation" (
+  id VARCHAR PRIMARY KEY,
+  project_id VARCHAR NOT NULL REFERENCES "project" (id) ON DELETE CASCADE,
+  user_id VARCHAR NOT NULL REFERENCES "users" (id) ON DELETE CASCADE,
+  role VARCHAR NOT NULL CHECK (role IN ('editor', 'viewer')),
+  invited_by VARCHAR REFERENCES "users" (id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (project_id, user_id)
+);
+CREATE INDEX "project_invitation_user_id_idx" ON "project_invitation" (user_id);
+-- プロジェクトのタグはメンバー全員で共有する
+ALTER TABLE "tag"
+ADD COLUMN project_id VARCHAR REFERENCES "project" (id) ON DELETE CASCADE;
+DROP INDEX "tag_owner_id_name_lower_key";
+CREATE UNIQUE INDEX "tag_owner_id_name_lower_key" ON "tag" (owner_id, lower(name))
+WHERE project_id IS NULL;
+CREATE UNIQUE INDEX "tag_project_id_name_lower_key" ON "tag" (project_id, lower(name))
+WHERE project_id IS NOT NULL;
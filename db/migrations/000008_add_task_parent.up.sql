@@ -0,0 +1,6 @@
+-- 親タスクを削除するとサブタスクも削除する
+ALTER TABLE "task"
+ADD COLUMN parent_id VARCHAR REFERENCES "task" (id) ON DELETE CASCADE;
+ALTER TABLE "task"
+ADD CONSTRAINT "task_parent_id_check" CHECK (parent_id <> id);
+CREATE INDEX "task_parent_id_idx" ON "task" (parent_id);
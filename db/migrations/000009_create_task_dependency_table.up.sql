@@ -0,0 +1,9 @@
+-- task_id は blocked_by_id が完了するまでブロックされる
+CREATE TABLE "task_dependency" (
+  task_id VARCHAR NOT NULL REFERENCES "task" (id) ON DELETE CASCADE,
+  blocked_by_id VARCHAR NOT NULL REFERENCES "task" (id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (task_id, blocked_by_id),
+  CONSTRAINT "task_dependency_self_check" CHECK (task_id <> blocked_by_id)
+);
+CREATE INDEX "task_dependency_blocked_by_id_idx" ON "task_dependency" (blocked_by_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS "task_status_id_idx";
+ALTER TABLE "task"
+ALTER COLUMN is_end DROP NOT NULL,
+  DROP COLUMN IF EXISTS status_changed_at,
+  DROP COLUMN IF EXISTS status_changed_by,
+  DROP COLUMN IF EXISTS status_id;
+DROP TABLE IF EXISTS "workflow_transition";
+DROP TABLE IF EXISTS "workflow_status";
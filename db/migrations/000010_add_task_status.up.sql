@@ -0,0 +1,85 @@
+-- project_id が NULL のステータスは個人タスクの既定のワークフローで、
+-- プロジェクト作成時にはこれがコピーされる
+CREATE TABLE "workflow_status" (
+  id VARCHAR PRIMARY KEY,
+  project_id VARCHAR REFERENCES "project" (id) ON DELETE CASCADE,
+  name VARCHAR NOT NULL,
+  -- このステータスのタスクは完了したものとして扱う
+  is_end BOOLEAN NOT NULL DEFAULT FALSE,
+  position INTEGER NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX "workflow_status_name_key" ON "workflow_status" (name)
+WHERE project_id IS NULL;
+CREATE UNIQUE INDEX "workflow_status_project_id_name_key" ON "workflow_status" (project_id, name)
+WHERE project_id IS NOT NULL;
+CREATE TABLE "workflow_transition" (
+  from_status_id VARCHAR NOT NULL REFERENCES "workflow_status" (id) ON DELETE CASCADE,
+  to_status_id VARCHAR NOT NULL REFERENCES "workflow_status" (id) ON DELETE CASCADE,
+  PRIMARY KEY (from_status_id, to_status_id),
+  CONSTRAINT "workflow_transition_self_check" CHECK (from_status_id <> to_status_id)
+);
+CREATE INDEX "workflow_transition_to_status_id_idx" ON "workflow_transition" (to_status_id);
+INSERT INTO "workflow_status" (id, project_id, name, is_end, position)
+VALUES (gen_random_uuid()::text, NULL, 'todo', FALSE, 0),
+  (gen_random_uuid()::text, NULL, 'in_progress', FALSE, 1),
+  (gen_random_uuid()::text, NULL, 'done', TRUE, 2),
+  (gen_random_uuid()::text, NULL, 'cancelled', TRUE, 3);
+INSERT INTO "workflow_transition" (from_status_id, to_status_id)
+SELECT f.id,
+  t.id
+FROM (
+    VALUES ('todo', 'in_progress'),
+      ('todo', 'done'),
+      ('todo', 'cancelled'),
+      ('in_progress', 'todo'),
+      ('in_progress', 'done'),
+      ('in_progress', 'cancelled'),
+      ('done', 'todo'),
+      ('cancelled', 'todo')
+  ) AS v (from_name, to_name)
+  JOIN "workflow_status" f ON f.name = v.from_name
+  AND f.project_id IS NULL
+  JOIN "workflow_status" t ON t.name = v.to_name
+  AND t.project_id IS NULL;
+-- 既存のプロジェクトにも既定のワークフローをコピーする
+INSERT INTO "workflow_status" (id, project_id, name, is_end, position)
+SELECT gen_random_uuid()::text,
+  p.id,
+  s.name,
+  s.is_end,
+  s.position
+FROM "project" p
+  CROSS JOIN "workflow_status" s
+WHERE s.project_id IS NULL;
+INSERT INTO "workflow_transition" (from_status_id, to_status_id)
+SELECT f.id,
+  t.id
+FROM "workflow_transition" wt
+  JOIN "workflow_status" df ON df.id = wt.from_status_id
+  AND df.project_id IS NULL
+  JOIN "workflow_status" dt ON dt.id = wt.to_status_id
+  JOIN "workflow_status" f ON f.name = df.name
+  AND f.project_id IS NOT NULL
+  JOIN "workflow_status" t ON t.name = dt.name
+  AND t.project_id = f.project_id;
+-- is_end はステータスから導かれる値として残す
+ALTER TABLE "task"
+ADD COLUMN status_id VARCHAR REFERENCES "workflow_status" (id),
+  ADD COLUMN status_changed_by VARCHAR REFERENCES "users" (id) ON DELETE SET NULL,
+  ADD COLUMN status_changed_at TIMESTAMPTZ;
+UPDATE "task"
+SET status_id = s.id
+FROM "workflow_status" s
+WHERE s.project_id IS NOT DISTINCT FROM task.project_id
+  AND s.name = CASE
+    WHEN task.is_end THEN 'done'
+    ELSE 'todo'
+  END;
+UPDATE "task"
+SET is_end = FALSE
+WHERE is_end IS NULL;
+ALTER TABLE "task"
+ALTER COLUMN status_id SET NOT NULL,
+  ALTER COLUMN is_end SET NOT NULL;
+CREATE INDEX "task_status_id_idx" ON "task" (status_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "task_project_id_position_idx";
+ALTER TABLE "task"
+DROP COLUMN IF EXISTS position,
+  DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,18 @@
+-- 0: none, 1: low, 2: medium, 3: high, 4: urgent
+ALTER TABLE "task"
+ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0 CONSTRAINT "task_priority_check" CHECK (
+    priority BETWEEN 0 AND 4
+  ),
+  ADD COLUMN position VARCHAR COLLATE "C";
+-- 既存のタスクは作成順に並べる。位置は末尾が0で終わらないようにする
+UPDATE "task" t
+SET position = p.position
+FROM (
+    SELECT id,
+      lpad((row_number() OVER (ORDER BY created_at, id))::text, 10, '0') || 'V' AS position
+    FROM "task"
+  ) p
+WHERE p.id = t.id;
+ALTER TABLE "task"
+ALTER COLUMN position SET NOT NULL;
+CREATE INDEX "task_project_id_position_idx" ON "task" (project_id, position);
@@ -0,0 +1,6 @@
+ALTER TABLE "task"
+DROP CONSTRAINT IF EXISTS "task_recurrence_check",
+  DROP CONSTRAINT IF EXISTS "task_previous_id_key",
+  DROP COLUMN IF EXISTS previous_id,
+  DROP COLUMN IF EXISTS recurrence_start,
+  DROP COLUMN IF EXISTS recurrence;
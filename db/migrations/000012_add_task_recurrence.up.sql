@@ -0,0 +1,8 @@
+-- recurrence は RFC 5545 の RRULE で、recurrence_start がその DTSTART になる
+ALTER TABLE "task"
+ADD COLUMN recurrence VARCHAR,
+  ADD COLUMN recurrence_start TIMESTAMPTZ,
+  -- 完了したときに作られた次の回は前の回を参照する
+  ADD COLUMN previous_id VARCHAR REFERENCES "task" (id) ON DELETE SET NULL,
+  ADD CONSTRAINT "task_previous_id_key" UNIQUE (previous_id),
+  ADD CONSTRAINT "task_recurrence_check" CHECK ((recurrence IS NULL) = (recurrence_start IS NULL));
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "task_limited_at_idx";
+DROP TABLE IF EXISTS "reminder";
@@ -0,0 +1,12 @@
+-- 送信済みのリマインダー。レプリカ間で同じリマインダーを二重に送らないよう、送信前にここへ登録する
+CREATE TABLE "reminder" (
+  task_id VARCHAR NOT NULL REFERENCES "task" (id) ON DELETE CASCADE,
+  -- 期限が変わったら改めてリマインドする
+  limited_at TIMESTAMPTZ NOT NULL,
+  -- 期限の何秒前のリマインダーか。0は期限切れの通知
+  remind_before INTEGER NOT NULL CHECK (remind_before >= 0),
+  sent_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (task_id, limited_at, remind_before)
+);
+CREATE INDEX "task_limited_at_idx" ON "task" (limited_at)
+WHERE NOT is_end;
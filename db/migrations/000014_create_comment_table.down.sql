@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "comment_revision";
+DROP TABLE IF EXISTS "comment";
+DROP FUNCTION IF EXISTS notify_task_child_event();
@@ -0,0 +1,56 @@
+CREATE TABLE "comment" (
+  id VARCHAR PRIMARY KEY,
+  task_id VARCHAR NOT NULL REFERENCES "task" (id) ON DELETE CASCADE,
+  -- 退会したユーザーのコメントは作成者なしで残す
+  author_id VARCHAR REFERENCES "users" (id) ON DELETE SET NULL,
+  body TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  edited_at TIMESTAMPTZ
+);
+CREATE INDEX "comment_task_id_idx" ON "comment" (task_id, id);
+-- 編集前の本文。created_at はその本文が書かれた日時
+CREATE TABLE "comment_revision" (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  comment_id VARCHAR NOT NULL REFERENCES "comment" (id) ON DELETE CASCADE,
+  body TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX "comment_revision_comment_id_idx" ON "comment_revision" (comment_id, id);
+-- task_idを持つ子テーブルの変更をタスクの更新として通知する。
+-- notify_task_tag_event() と同じ内容だが、task_tag用の変更がコメントに及ばないよう分けておく
+CREATE OR REPLACE FUNCTION notify_task_child_event() RETURNS TRIGGER AS $$
+DECLARE changed_task_id VARCHAR;
+changed_owner_id VARCHAR;
+changed_project_id VARCHAR;
+BEGIN IF TG_OP = 'INSERT' THEN changed_task_id := NEW.task_id;
+ELSE changed_task_id := OLD.task_id;
+END IF;
+SELECT owner_id,
+  project_id INTO changed_owner_id,
+  changed_project_id
+FROM "task"
+WHERE id = changed_task_id;
+-- タスク削除に伴うカスケード削除では通知しない
+IF NOT FOUND THEN RETURN NULL;
+END IF;
+PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    changed_task_id,
+    'owner_id',
+    changed_owner_id,
+    'project_id',
+    changed_project_id
+  )::text
+);
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- コメント数が変わるため、タスクの購読者に更新を通知する
+CREATE TRIGGER notify_comment_event
+AFTER
+INSERT
+  OR DELETE ON "comment" FOR EACH ROW EXECUTE FUNCTION notify_task_child_event();
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "attachment";
+DROP FUNCTION IF EXISTS queue_attachment_orphan();
+DROP TABLE IF EXISTS "attachment_orphan";
@@ -0,0 +1,24 @@
+CREATE TABLE "attachment" (
+  id VARCHAR PRIMARY KEY,
+  task_id VARCHAR NOT NULL REFERENCES "task" (id) ON DELETE CASCADE,
+  uploaded_by VARCHAR REFERENCES "users" (id) ON DELETE SET NULL,
+  filename VARCHAR NOT NULL,
+  content_type VARCHAR NOT NULL,
+  size BIGINT NOT NULL CHECK (size > 0),
+  object_key VARCHAR NOT NULL UNIQUE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "attachment_task_id_idx" ON "attachment" (task_id, id);
+-- 削除された添付ファイルのオブジェクト。タスクのカスケード削除も含めて、ストレージからの削除はバックグラウンドで行う
+CREATE TABLE "attachment_orphan" (
+  object_key VARCHAR PRIMARY KEY,
+  deleted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE OR REPLACE FUNCTION queue_attachment_orphan() RETURNS TRIGGER AS $$ BEGIN
+INSERT INTO "attachment_orphan" (object_key)
+VALUES (OLD.object_key) ON CONFLICT DO NOTHING;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER queue_attachment_orphan
+AFTER DELETE ON "attachment" FOR EACH ROW EXECUTE FUNCTION queue_attachment_orphan();
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "task_event";
+DROP FUNCTION IF EXISTS reject_task_event_change();
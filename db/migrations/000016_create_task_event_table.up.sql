@@ -0,0 +1,18 @@
+-- タスクの変更履歴。削除されたタスクや退会したユーザーの履歴も残すため外部キーは張らない
+CREATE TABLE "task_event" (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  task_id VARCHAR NOT NULL,
+  actor_id VARCHAR NOT NULL,
+  action VARCHAR NOT NULL CHECK (action IN ('created', 'updated', 'deleted')),
+  -- [{"field": ..., "before": ..., "after": ...}]
+  changes JSONB NOT NULL DEFAULT '[]',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX "task_event_task_id_idx" ON "task_event" (task_id, id);
+-- 履歴は追記のみとし、書き換えや削除はできないようにする
+CREATE OR REPLACE FUNCTION reject_task_event_change() RETURNS TRIGGER AS $$ BEGIN RAISE EXCEPTION 'task_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER reject_task_event_change BEFORE
+UPDATE
+  OR DELETE ON "task_event" FOR EACH ROW EXECUTE FUNCTION reject_task_event_change();
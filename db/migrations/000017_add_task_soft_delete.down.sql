@@ -0,0 +1,52 @@
+CREATE OR REPLACE FUNCTION notify_task_event() RETURNS TRIGGER AS $$ BEGIN IF TG_OP = 'INSERT' THEN PERFORM pg_notify(
+    'task_events',
+    json_build_object(
+      'type',
+      'created',
+      'task_id',
+      NEW.id,
+      'owner_id',
+      NEW.owner_id,
+      'project_id',
+      NEW.project_id
+    )::text
+  );
+ELSIF TG_OP = 'UPDATE' THEN PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'updated',
+    'task_id',
+    NEW.id,
+    'owner_id',
+    NEW.owner_id,
+    'project_id',
+    NEW.project_id
+  )::text
+);
+ELSE PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    'deleted',
+    'task_id',
+    OLD.id,
+    'owner_id',
+    OLD.owner_id,
+    'project_id',
+    OLD.project_id
+  )::text
+);
+END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- 復元の履歴は消せないため、既存の行は検証しない
+ALTER TABLE "task_event" DROP CONSTRAINT "task_event_action_check",
+  ADD CONSTRAINT "task_event_action_check" CHECK (action IN ('created', 'updated', 'deleted')) NOT VALID;
+-- ゴミ箱の中のタスクが元に戻らないよう、先に完全に削除する
+DELETE FROM "task"
+WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS "task_deleted_at_idx";
+ALTER TABLE "task" DROP COLUMN IF EXISTS deleted_at,
+  DROP COLUMN IF EXISTS deleted_by;
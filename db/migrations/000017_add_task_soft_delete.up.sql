@@ -0,0 +1,46 @@
+-- 削除したタスクはゴミ箱に入り、保持期間を過ぎると完全に削除される
+ALTER TABLE "task"
+ADD COLUMN deleted_at TIMESTAMPTZ,
+  ADD COLUMN deleted_by VARCHAR REFERENCES "users" (id) ON DELETE SET NULL;
+CREATE INDEX "task_deleted_at_idx" ON "task" (deleted_at)
+WHERE deleted_at IS NOT NULL;
+-- ゴミ箱への移動は削除、ゴミ箱からの復元は作成として通知し、ゴミ箱の中の変更は通知しない
+CREATE OR REPLACE FUNCTION notify_task_event() RETURNS TRIGGER AS $$
+DECLARE event_type VARCHAR;
+changed "task";
+BEGIN IF TG_OP = 'INSERT' THEN event_type := 'created';
+changed := NEW;
+ELSIF TG_OP = 'DELETE' THEN IF OLD.deleted_at IS NOT NULL THEN RETURN NULL;
+END IF;
+event_type := 'deleted';
+changed := OLD;
+ELSIF OLD.deleted_at IS NULL
+AND NEW.deleted_at IS NOT NULL THEN event_type := 'deleted';
+changed := NEW;
+ELSIF OLD.deleted_at IS NOT NULL
+AND NEW.deleted_at IS NULL THEN event_type := 'created';
+changed := NEW;
+ELSIF NEW.deleted_at IS NOT NULL THEN RETURN NULL;
+ELSE event_type := 'updated';
+changed := NEW;
+END IF;
+PERFORM pg_notify(
+  'task_events',
+  json_build_object(
+    'type',
+    event_type,
+    'task_id',
+    changed.id,
+    'owner_id',
+    changed.owner_id,
+    'project_id',
+    changed.project_id
+  )::text
+);
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+ALTER TABLE "task_event" DROP CONSTRAINT "task_event_action_check",
+  ADD CONSTRAINT "task_event_action_check" CHECK (
+    action IN ('created', 'updated', 'deleted', 'restored')
+  );
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_version ON "task";
+DROP FUNCTION IF EXISTS increment_task_version();
+ALTER TABLE "task" DROP COLUMN IF EXISTS version;
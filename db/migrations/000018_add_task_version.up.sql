@@ -0,0 +1,9 @@
+-- 更新のたびに増えるバージョンをETagとして返し、読み取った後の変更を検出する
+ALTER TABLE "task"
+ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+CREATE OR REPLACE FUNCTION increment_task_version() RETURNS TRIGGER AS $$ BEGIN NEW.version = OLD.version + 1;
+RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER set_version BEFORE
+UPDATE ON "task" FOR EACH ROW EXECUTE FUNCTION increment_task_version();
@@ -0,0 +1,10 @@
+-- 送信に失敗したリマインダー。失敗が続くリマインダーが他のリマインダーの送信を妨げないよう、次の再送まで間隔を空ける
+CREATE TABLE "reminder_failure" (
+  task_id VARCHAR NOT NULL REFERENCES "task" (id) ON DELETE CASCADE,
+  limited_at TIMESTAMPTZ NOT NULL,
+  remind_before INTEGER NOT NULL CHECK (remind_before >= 0),
+  attempts INTEGER NOT NULL DEFAULT 1,
+  last_error TEXT NOT NULL,
+  next_attempt_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (task_id, limited_at, remind_before)
+);
@@ -0,0 +1,8 @@
+package docs
+
+import _ "embed"
+
+// OpenAPI is the swagger document generated by `make genswag`.
+//
+//go:embed apidocs.swagger.json
+var OpenAPI []byte
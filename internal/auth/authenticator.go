@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type APIKeyStore interface {
+	GetAPIKeyByHash(ctx context.Context, arg domain.GetAPIKeyByHashParam) (*domain.APIKey, error)
+}
+
+// Authenticator resolves the bearer credential of a request, either an access
+// token or an API key, to a user ID.
+type Authenticator struct {
+	tokens  *TokenManager
+	apiKeys APIKeyStore
+}
+
+func NewAuthenticator(tokens *TokenManager, apiKeys APIKeyStore) *Authenticator {
+	return &Authenticator{
+		tokens:  tokens,
+		apiKeys: apiKeys,
+	}
+}
+
+// Authenticate returns the user of credential, or ErrInvalidToken when it is
+// not a valid token or API key.
+func (a *Authenticator) Authenticate(ctx context.Context, credential string) (string, error) {
+	if !isAPIKey(credential) {
+		return a.tokens.Verify(credential)
+	}
+	apiKey, err := a.apiKeys.GetAPIKeyByHash(ctx, domain.GetAPIKeyByHashParam{KeyHash: HashAPIKey(credential)})
+	if errors.Is(err, domain.ErrNotFound) {
+		return "", ErrInvalidToken
+	}
+	if err != nil {
+		return "", err
+	}
+	return apiKey.UserID, nil
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrNoSigningKey = errors.New("token signing key is not configured")
+)
+
+// TokenManager issues and verifies the access tokens returned by AuthService.
+type TokenManager struct {
+	method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+	ttl       time.Duration
+}
+
+// NewHS256TokenManager signs tokens with a shared secret.
+func NewHS256TokenManager(secret []byte, ttl time.Duration) *TokenManager {
+	return &TokenManager{
+		method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+		ttl:       ttl,
+	}
+}
+
+// NewRS256TokenManager signs tokens with privateKey and verifies them with
+// publicKey. privateKey may be nil on servers that only verify tokens.
+func NewRS256TokenManager(privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey, ttl time.Duration) *TokenManager {
+	m := &TokenManager{
+		method:    jwt.SigningMethodRS256,
+		verifyKey: publicKey,
+		ttl:       ttl,
+	}
+	if privateKey != nil {
+		m.signKey = privateKey
+	}
+	return m
+}
+
+// Issue returns a signed token for userID and the time it expires.
+func (m *TokenManager) Issue(userID string) (string, time.Time, error) {
+	if m.signKey == nil {
+		return "", time.Time{}, ErrNoSigningKey
+	}
+	now := time.Now()
+	expiresAt := now.Add(m.ttl)
+	token := jwt.NewWithClaims(m.method, jwt.RegisteredClaims{
+		Subject:   userID,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	})
+	signed, err := token.SignedString(m.signKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// Verify checks the signature and expiry of token and returns its user ID.
+func (m *TokenManager) Verify(token string) (string, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return m.verifyKey, nil
+	}, jwt.WithValidMethods([]string{m.method.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || claims.Subject == "" {
+		return "", ErrInvalidToken
+	}
+	return claims.Subject, nil
+}
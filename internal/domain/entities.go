@@ -4,22 +4,256 @@ import "time"
 
 type Task struct {
 	ID          string `json:"id"`
+	OwnerID     string `json:"owner_id"`
+	ProjectID   string `json:"project_id"`
+	ParentID    string `json:"parent_id"`
 	Title       string `json:"title" validate:"required"`
 	Description string `json:"description"`
 
+	StatusID string `json:"status_id"`
+	Status   string `json:"status"`
+	// IsEnd is derived from the status of the task.
 	IsEnd bool `json:"is_end"`
+	// Blocked is set while a task blocking this one is not done.
+	Blocked bool `json:"blocked"`
+	// StatusChangedBy is empty until the status is changed for the first time.
+	StatusChangedBy string     `json:"status_changed_by"`
+	StatusChangedAt *time.Time `json:"status_changed_at"`
+
+	Priority TaskPriority `json:"priority"`
+	// Position orders tasks by hand, see PositionBetween.
+	Position string `json:"position"`
+	// Recurrence is an RFC 5545 RRULE starting at RecurrenceStart. It is
+	// empty for tasks that do not repeat.
+	Recurrence      string     `json:"recurrence"`
+	RecurrenceStart *time.Time `json:"recurrence_start"`
+
+	CommentCount int32 `json:"comment_count"`
+
+	// DeletedAt is set while the task is in the trash. DeletedBy is empty
+	// once the user has been deleted.
+	DeletedAt *time.Time `json:"deleted_at"`
+	DeletedBy string     `json:"deleted_by"`
+
+	// Version is incremented every time the task row is updated.
+	Version int64 `json:"version"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdateAt  time.Time `json:"updated_at"`
 	LimitedAt time.Time `json:"limited_at"`
 }
 
+// TaskPriority is stored as a number so that tasks can be ordered by it.
+type TaskPriority int16
+
+const (
+	TaskPriorityNone TaskPriority = iota
+	TaskPriorityLow
+	TaskPriorityMedium
+	TaskPriorityHigh
+	TaskPriorityUrgent
+)
+
+// TaskProgress counts the subtasks below a task, at any depth.
+type TaskProgress struct {
+	Total int32 `json:"total"`
+	Done  int32 `json:"done"`
+}
+
+// Percent returns the share of done subtasks. A task without subtasks is
+// either 0 or 100 percent done depending on isEnd.
+func (p TaskProgress) Percent(isEnd bool) int32 {
+	if p.Total == 0 {
+		if isEnd {
+			return 100
+		}
+		return 0
+	}
+	return p.Done * 100 / p.Total
+}
+
+type User struct {
+	ID           string    `json:"id"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type APIKey struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	KeyPrefix string    `json:"key_prefix"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Project struct {
+	ID      string `json:"id"`
+	OwnerID string `json:"owner_id"`
+	Name    string `json:"name"`
+	// Role is the role of the user the project was fetched for.
+	Role ProjectRole `json:"role"`
+
+	ArchivedAt *time.Time `json:"archived_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+type ProjectRole string
+
+const (
+	ProjectRoleOwner  ProjectRole = "owner"
+	ProjectRoleEditor ProjectRole = "editor"
+	ProjectRoleViewer ProjectRole = "viewer"
+)
+
+var projectRoleRank = map[ProjectRole]int{
+	ProjectRoleViewer: 1,
+	ProjectRoleEditor: 2,
+	ProjectRoleOwner:  3,
+}
+
+// Allows reports whether r permits everything required permits.
+func (r ProjectRole) Allows(required ProjectRole) bool {
+	return projectRoleRank[r] >= projectRoleRank[required]
+}
+
+type ProjectMember struct {
+	ProjectID string      `json:"project_id"`
+	UserID    string      `json:"user_id"`
+	Role      ProjectRole `json:"role"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type ProjectInvitation struct {
+	ID        string      `json:"id"`
+	ProjectID string      `json:"project_id"`
+	UserID    string      `json:"user_id"`
+	Role      ProjectRole `json:"role"`
+	InvitedBy string      `json:"invited_by"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
 type Tag struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID        string `json:"id"`
+	ProjectID string `json:"project_id"`
+	Name      string `json:"name"`
+}
+
+// WorkflowStatus is a status tasks can be in. Personal tasks use the default
+// workflow, whose statuses have no ProjectID.
+type WorkflowStatus struct {
+	ID        string `json:"id"`
+	ProjectID string `json:"project_id"`
+	Name      string `json:"name"`
+	// IsEnd marks statuses that count as done.
+	IsEnd    bool  `json:"is_end"`
+	Position int32 `json:"position"`
+}
+
+// WorkflowTransition allows moving a task from one status to another. The
+// statuses are referred to by name.
+type WorkflowTransition struct {
+	From string `json:"from"`
+	To   string `json:"to"`
 }
 
 type TaskTag struct {
 	TaskID string `json:"task_id"`
 	TagID  string `json:"tag_id"`
 }
+
+// Reminder tells the owner of a task that its limited_at is Before away, or
+// that it is overdue when Before is zero.
+type Reminder struct {
+	TaskID    string        `json:"task_id"`
+	Title     string        `json:"title"`
+	LimitedAt time.Time     `json:"limited_at"`
+	Before    time.Duration `json:"before"`
+	UserID    string        `json:"user_id"`
+	Email     string        `json:"email"`
+}
+
+func (r Reminder) Overdue() bool {
+	return r.Before == 0
+}
+
+type Comment struct {
+	ID     string `json:"id"`
+	TaskID string `json:"task_id"`
+	// AuthorID is empty once the author has been deleted.
+	AuthorID  string     `json:"author_id"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at"`
+}
+
+// CommentRevision is a body a comment had before it was edited.
+type CommentRevision struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Attachment is a file stored in object storage under ObjectKey.
+type Attachment struct {
+	ID     string `json:"id"`
+	TaskID string `json:"task_id"`
+	// UploadedBy is empty once the user has been deleted.
+	UploadedBy  string    `json:"uploaded_by"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	ObjectKey   string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// PresignedURL lets a client access an object directly until ExpiresAt.
+// Header lists the headers the request has to be sent with.
+type PresignedURL struct {
+	URL       string            `json:"url"`
+	Header    map[string]string `json:"header"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+// TaskHistory is a change made to a task, kept after the task is deleted.
+type TaskHistory struct {
+	ID        int64             `json:"id"`
+	TaskID    string            `json:"task_id"`
+	ActorID   string            `json:"actor_id"`
+	Action    TaskHistoryAction `json:"action"`
+	Changes   []FieldChange     `json:"changes"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type TaskHistoryAction string
+
+const (
+	TaskHistoryCreated  TaskHistoryAction = "created"
+	TaskHistoryUpdated  TaskHistoryAction = "updated"
+	TaskHistoryDeleted  TaskHistoryAction = "deleted"
+	TaskHistoryRestored TaskHistoryAction = "restored"
+)
+
+// FieldChange is the value of a task field before and after a change, in the
+// form it is shown to users.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type TaskEventType string
+
+const (
+	TaskEventCreated TaskEventType = "created"
+	TaskEventUpdated TaskEventType = "updated"
+	TaskEventDeleted TaskEventType = "deleted"
+)
+
+// TaskEvent is published by the database whenever a task or its tags change.
+type TaskEvent struct {
+	Type      TaskEventType `json:"type"`
+	TaskID    string        `json:"task_id"`
+	OwnerID   string        `json:"owner_id"`
+	ProjectID string        `json:"project_id"`
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrConflict           = errors.New("conflict")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+)
+
+// Error is a failure that can be reported to clients. Kind is one of the Err*
+// sentinels above, so callers can match it with errors.Is.
+type Error struct {
+	Kind       error
+	Reason     string
+	Resource   string
+	Field      string
+	Message    string
+	Violations []FieldViolation
+	Err        error
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	return e.Kind == target
+}
+
+func NewNotFoundError(resource string, err error) *Error {
+	return &Error{
+		Kind:     ErrNotFound,
+		Reason:   "NOT_FOUND",
+		Resource: resource,
+		Message:  resource + " not found",
+		Err:      err,
+	}
+}
+
+func NewAlreadyExistsError(resource, field string, err error) *Error {
+	return &Error{
+		Kind:     ErrAlreadyExists,
+		Reason:   "ALREADY_EXISTS",
+		Resource: resource,
+		Field:    field,
+		Message:  resource + " already exists",
+		Err:      err,
+	}
+}
+
+func NewInvalidArgumentError(field, message string) *Error {
+	return &Error{
+		Kind:    ErrInvalidArgument,
+		Reason:  "INVALID_ARGUMENT",
+		Field:   field,
+		Message: message,
+	}
+}
+
+func NewValidationError(violations []FieldViolation) *Error {
+	return &Error{
+		Kind:       ErrInvalidArgument,
+		Reason:     "INVALID_ARGUMENT",
+		Message:    "request validation failed",
+		Violations: violations,
+	}
+}
+
+func NewFailedPreconditionError(reason, message string) *Error {
+	return &Error{
+		Kind:    ErrFailedPrecondition,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func NewConflictError(resource string, err error) *Error {
+	return &Error{
+		Kind:     ErrConflict,
+		Reason:   "CONFLICT",
+		Resource: resource,
+		Message:  resource + " was modified concurrently",
+		Err:      err,
+	}
+}
+
+func NewUnavailableError(reason, message string) *Error {
+	return &Error{
+		Kind:    ErrUnavailable,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func NewUnauthenticatedError(message string) *Error {
+	return &Error{
+		Kind:    ErrUnauthenticated,
+		Reason:  "UNAUTHENTICATED",
+		Message: message,
+	}
+}
+
+func NewPermissionDeniedError(resource, message string) *Error {
+	return &Error{
+		Kind:     ErrPermissionDenied,
+		Reason:   "PERMISSION_DENIED",
+		Resource: resource,
+		Message:  message,
+	}
+}
@@ -3,63 +3,541 @@ package domain
 import "time"
 
 type CreateTaskParam struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title" validate:"required"`
-	Description string    `json:"description"`
-	LimitedAt   time.Time `json:"limited_at"`
-	IsEnd       bool      `json:"is_end"`
+	ID          string       `json:"id"`
+	OwnerID     string       `json:"owner_id"`
+	ProjectID   string       `json:"project_id"`
+	ParentID    string       `json:"parent_id"`
+	Title       string       `json:"title" validate:"required"`
+	Description string       `json:"description"`
+	LimitedAt   time.Time    `json:"limited_at"`
+	Priority    TaskPriority `json:"priority"`
+	Position    string       `json:"position"`
+	// RecurrenceStart must be set together with Recurrence.
+	Recurrence      string     `json:"recurrence"`
+	RecurrenceStart *time.Time `json:"recurrence_start"`
 
 	TagIDs []string `json:"tag_ids"`
 }
 
 type GetTaskParam struct {
 	ID string `json:"id"`
+	// UserID is the user making the request. Only tasks visible to them are
+	// read or changed.
+	UserID string `json:"user_id"`
+	// IncludeDeleted also gets the task while it is in the trash.
+	IncludeDeleted bool `json:"include_deleted"`
 }
 
+// Task fields that can be used in ListTaskParam.OrderBy.
+const (
+	TaskOrderCreatedAt = "created_at"
+	TaskOrderUpdatedAt = "updated_at"
+	TaskOrderLimitedAt = "limited_at"
+	TaskOrderTitle     = "title"
+	TaskOrderPriority  = "priority"
+	TaskOrderPosition  = "position"
+	TaskOrderDeletedAt = "deleted_at"
+)
+
 type ListTaskParam struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	UserID string `json:"user_id"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+
+	// AfterID returns only tasks after the task with this ID in the list order.
+	AfterID string `json:"after_id"`
+	// AfterValue is the OrderBy field of the AfterID task. It is ignored when
+	// OrderBy is empty.
+	AfterValue string `json:"after_value"`
+
+	Filter TaskFilter `json:"filter"`
+	// OrderBy is one of the TaskOrder* fields. Tasks are ordered by ID when empty.
+	OrderBy string `json:"order_by"`
+	Desc    bool   `json:"desc"`
+}
+
+type TaskFilter struct {
+	IsEnd         *bool      `json:"is_end"`
+	TagIDs        []string   `json:"tag_ids"`
+	MatchAllTags  bool       `json:"match_all_tags"`
+	LimitedBefore *time.Time `json:"limited_before"`
+	LimitedAfter  *time.Time `json:"limited_after"`
+	CreatedBefore *time.Time `json:"created_before"`
+	CreatedAfter  *time.Time `json:"created_after"`
+	TitleContains string     `json:"title_contains"`
+	ProjectID     string     `json:"project_id"`
+	ParentID      string     `json:"parent_id"`
+	Status        string     `json:"status"`
+	// Deleted lists the trash instead of the other tasks. Subtasks that are
+	// in the trash with their parent are left out.
+	Deleted bool `json:"deleted"`
 }
 
+// Task fields that can be listed in UpdateTaskParam.UpdateMask.
+const (
+	TaskFieldTitle       = "title"
+	TaskFieldDescription = "description"
+	TaskFieldLimitedAt   = "limited_at"
+	TaskFieldTagIDs      = "tag_ids"
+	TaskFieldParentID    = "parent_id"
+	TaskFieldPriority    = "priority"
+	TaskFieldRecurrence  = "recurrence"
+)
+
+// Task fields that are only changed through their own RPCs, as recorded in
+// the task history.
+const (
+	TaskFieldStatus      = "status"
+	TaskFieldPosition    = "position"
+	TaskFieldBlockedByID = "blocked_by_id"
+	// TaskFieldIsEnd changes when the workflow makes the status of a task an
+	// end status or no longer one. UpdateTask also accepts it, moving the task
+	// through the workflow like TransitionTask.
+	TaskFieldIsEnd = "is_end"
+)
+
 type UpdateTaskParam struct {
-	ID          string    `json:"id" validate:"required"`
-	Title       string    `json:"title" validate:"required"`
-	Description string    `json:"description"`
-	LimitedAt   time.Time `json:"limited_at"`
-	IsEnd       bool      `json:"is_end"`
+	ID          string       `json:"id" validate:"required"`
+	UserID      string       `json:"user_id"`
+	Title       string       `json:"title" validate:"required"`
+	Description string       `json:"description"`
+	LimitedAt   time.Time    `json:"limited_at"`
+	Priority    TaskPriority `json:"priority"`
+	// Recurrence stops the task from repeating when empty.
+	Recurrence      string     `json:"recurrence"`
+	RecurrenceStart *time.Time `json:"recurrence_start"`
+	// ParentID detaches the task from its parent when empty.
+	ParentID string `json:"parent_id"`
+
+	UpdateMask []string `json:"update_mask"`
+	// Version makes the update fail with ErrConflict when the task has been
+	// changed since it was read. Zero skips the check.
+	Version int64 `json:"version"`
+}
+
+// TransitionTaskParam moves a task to StatusID, provided that it is still in
+// FromStatusID. UserID is recorded as the user who changed the status.
+type TransitionTaskParam struct {
+	ID           string `json:"id"`
+	UserID       string `json:"user_id"`
+	FromStatusID string `json:"from_status_id"`
+	StatusID     string `json:"status_id"`
+	IsEnd        bool   `json:"is_end"`
+}
+
+// TaskScopeParam selects the tasks that are ordered together: the tasks of
+// ProjectID, or the personal tasks of OwnerID when it is empty.
+type TaskScopeParam struct {
+	ProjectID string `json:"project_id"`
+	OwnerID   string `json:"owner_id"`
+}
+
+// GetNeighborPositionParam finds the closest position after Position in the
+// scope, or before it when Before is set. ExcludeID is left out, so that a
+// task that is being moved is not its own neighbor.
+type GetNeighborPositionParam struct {
+	Scope     TaskScopeParam `json:"scope"`
+	Position  string         `json:"position"`
+	Before    bool           `json:"before"`
+	ExcludeID string         `json:"exclude_id"`
+}
+
+// CreateNextOccurrenceParam creates the task ID that follows PreviousID in a
+// recurring series. The task is not created when PreviousID already has one.
+type CreateNextOccurrenceParam struct {
+	ID         string    `json:"id"`
+	PreviousID string    `json:"previous_id"`
+	LimitedAt  time.Time `json:"limited_at"`
+	Position   string    `json:"position"`
+}
+
+type MoveTaskParam struct {
+	ID       string `json:"id"`
+	Position string `json:"position"`
+}
+
+// SubtaskParam selects every task below TaskID in the task tree.
+type SubtaskParam struct {
+	TaskID string `json:"task_id"`
+}
+
+// SyncTaskEndParam sets is_end of the tasks of a project that are not in the
+// trash to that of their status after the workflow has changed.
+type SyncTaskEndParam struct {
+	ProjectID string `json:"project_id"`
+}
+
+// CompleteSubtaskParam moves every open task below TaskID to StatusID.
+type CompleteSubtaskParam struct {
+	TaskID   string `json:"task_id"`
+	UserID   string `json:"user_id"`
+	StatusID string `json:"status_id"`
+}
+
+type IsTaskDescendantParam struct {
+	TaskID     string `json:"task_id"`
+	AncestorID string `json:"ancestor_id"`
+}
+
+type ListTaskProgressParam struct {
+	TaskIDs []string `json:"task_ids"`
+}
+
+type CreateTaskDependencyParam struct {
+	TaskID      string `json:"task_id"`
+	BlockedByID string `json:"blocked_by_id"`
+}
+
+type DeleteTaskDependencyParam struct {
+	TaskID      string `json:"task_id"`
+	BlockedByID string `json:"blocked_by_id"`
+}
+
+// IsTaskBlockedByParam asks whether TaskID waits for BlockedByID, directly or
+// through other dependencies.
+type IsTaskBlockedByParam struct {
+	TaskID      string `json:"task_id"`
+	BlockedByID string `json:"blocked_by_id"`
+}
+
+type CountOpenBlockerParam struct {
+	TaskID string `json:"task_id"`
+}
+
+// Workflow params select the workflow of ProjectID, or the default workflow
+// when it is empty.
+type GetWorkflowStatusParam struct {
+	ProjectID string `json:"project_id"`
+	Name      string `json:"name"`
+}
+
+type ListWorkflowParam struct {
+	ProjectID string `json:"project_id"`
 }
 
+type IsTransitionAllowedParam struct {
+	FromStatusID string `json:"from_status_id"`
+	ToStatusID   string `json:"to_status_id"`
+}
+
+type CreateProjectWorkflowParam struct {
+	ProjectID string `json:"project_id"`
+}
+
+// ReplaceWorkflowParam replaces the statuses and transitions of a project.
+// Statuses are matched by name, so tasks keep the status they are in.
+type ReplaceWorkflowParam struct {
+	ProjectID   string               `json:"project_id"`
+	Statuses    []WorkflowStatus     `json:"statuses"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+// DeleteTaskParam moves a task and its subtasks to the trash.
 type DeleteTaskParam struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	// Version is checked like UpdateTaskParam.Version.
+	Version int64 `json:"version"`
+}
+
+// RestoreTaskParam takes a task out of the trash together with the subtasks
+// that were deleted with it.
+type RestoreTaskParam struct {
 	ID string `json:"id"`
 }
 
+// PurgeTaskParam permanently deletes a task in the trash.
+type PurgeTaskParam struct {
+	ID string `json:"id"`
+}
+
+// PurgeDeletedTaskParam permanently deletes up to Limit tasks that were moved
+// to the trash before DeletedBefore.
+type PurgeDeletedTaskParam struct {
+	DeletedBefore time.Time `json:"deleted_before"`
+	Limit         int32     `json:"limit"`
+}
+
 type CreateTagParam struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID        string `json:"id"`
+	OwnerID   string `json:"owner_id"`
+	ProjectID string `json:"project_id"`
+	Name      string `json:"name"`
 }
 
+// Tag params take the requesting user as UserID and only match their personal
+// tags and the tags of the projects they are a member of.
 type GetTagParam struct {
-	ID string `json:"id"`
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
 }
 
 type ListTagParam struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	UserID string `json:"user_id"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+
+	// AfterID returns only tags created after the tag with this ID.
+	AfterID string `json:"after_id"`
+}
+
+type UpdateTagParam struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
 }
 
 type DeleteTagParam struct {
-	ID string `json:"id"`
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
 }
 
 type CreateTaskTagParam struct {
 	TaskID string `json:"task_id"`
 	TagID  string `json:"tag_id"`
+	// UserID must be able to see the tag.
+	UserID string `json:"user_id"`
 }
 
 type GetTaskTagParam struct {
 	TaskID string `json:"task_id"`
 }
 
+type ListTaskTagParam struct {
+	TaskIDs []string `json:"task_ids"`
+}
+
+type CopyTaskTagParam struct {
+	FromTaskID string `json:"from_task_id"`
+	ToTaskID   string `json:"to_task_id"`
+}
+
 type DeleteTaskTagParam struct {
 	TaskID string `json:"task_id"`
 }
+
+type CreateUserParam struct {
+	ID           string `json:"id"`
+	Email        string `json:"email"`
+	PasswordHash string `json:"-"`
+}
+
+type GetUserByEmailParam struct {
+	Email string `json:"email"`
+}
+
+// ClaimOwnerlessDataParam gives UserID the tasks and tags left without an
+// owner when user accounts were introduced.
+type ClaimOwnerlessDataParam struct {
+	UserID string `json:"user_id"`
+}
+
+type CreateAPIKeyParam struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+	KeyPrefix string `json:"key_prefix"`
+	KeyHash   string `json:"-"`
+}
+
+type GetAPIKeyByHashParam struct {
+	KeyHash string `json:"-"`
+}
+
+type ListAPIKeyParam struct {
+	UserID string `json:"user_id"`
+}
+
+type DeleteAPIKeyParam struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+type CreateProjectParam struct {
+	ID      string `json:"id"`
+	OwnerID string `json:"owner_id"`
+	Name    string `json:"name"`
+}
+
+// Project params take the requesting user as UserID and only match projects
+// they are a member of.
+type GetProjectParam struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+type ListProjectParam struct {
+	UserID          string `json:"user_id"`
+	IncludeArchived bool   `json:"include_archived"`
+	Limit           int32  `json:"limit"`
+	Offset          int32  `json:"offset"`
+
+	// AfterID returns only projects created after the project with this ID.
+	AfterID string `json:"after_id"`
+}
+
+type RenameProjectParam struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+type ArchiveProjectParam struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+type CreateProjectMemberParam struct {
+	ProjectID string      `json:"project_id"`
+	UserID    string      `json:"user_id"`
+	Role      ProjectRole `json:"role"`
+}
+
+type ListProjectMemberParam struct {
+	ProjectID string `json:"project_id"`
+}
+
+type DeleteProjectMemberParam struct {
+	ProjectID string `json:"project_id"`
+	UserID    string `json:"user_id"`
+}
+
+type CreateProjectInvitationParam struct {
+	ID        string      `json:"id"`
+	ProjectID string      `json:"project_id"`
+	UserID    string      `json:"user_id"`
+	Role      ProjectRole `json:"role"`
+	InvitedBy string      `json:"invited_by"`
+}
+
+// GetProjectInvitationParam only matches invitations addressed to UserID.
+type GetProjectInvitationParam struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+type ListProjectInvitationParam struct {
+	UserID string `json:"user_id"`
+}
+
+type DeleteProjectInvitationParam struct {
+	ProjectID string `json:"project_id"`
+	UserID    string `json:"user_id"`
+}
+
+// ListDueReminderParam lists the reminders due at Now, the most urgent first.
+// A task only gets the reminder for its smallest window among Windows that
+// has not been sent yet, unless its last delivery failed too recently.
+type ListDueReminderParam struct {
+	Windows []time.Duration `json:"windows"`
+	Now     time.Time       `json:"now"`
+	Limit   int32           `json:"limit"`
+}
+
+type CreateReminderParam struct {
+	TaskID    string        `json:"task_id"`
+	LimitedAt time.Time     `json:"limited_at"`
+	Before    time.Duration `json:"before"`
+}
+
+// RecordReminderFailureParam postpones the next attempt to deliver a reminder
+// by Backoff after FailedAt, doubled for every earlier failure up to
+// MaxBackoff.
+type RecordReminderFailureParam struct {
+	TaskID     string        `json:"task_id"`
+	LimitedAt  time.Time     `json:"limited_at"`
+	Before     time.Duration `json:"before"`
+	FailedAt   time.Time     `json:"failed_at"`
+	Error      string        `json:"error"`
+	Backoff    time.Duration `json:"backoff"`
+	MaxBackoff time.Duration `json:"max_backoff"`
+}
+
+type CreateCommentParam struct {
+	ID       string `json:"id"`
+	TaskID   string `json:"task_id"`
+	AuthorID string `json:"author_id"`
+	Body     string `json:"body"`
+}
+
+type GetCommentParam struct {
+	ID string `json:"id"`
+}
+
+type ListCommentParam struct {
+	TaskID  string `json:"task_id"`
+	Limit   int32  `json:"limit"`
+	AfterID string `json:"after_id"`
+}
+
+type ListCommentRevisionParam struct {
+	CommentIDs []string `json:"comment_ids"`
+}
+
+// UpdateCommentParam replaces the body of a comment and keeps the previous
+// body as a revision.
+type UpdateCommentParam struct {
+	ID   string `json:"id"`
+	Body string `json:"body"`
+}
+
+type DeleteCommentParam struct {
+	ID string `json:"id"`
+}
+
+type CreateAttachmentParam struct {
+	ID          string `json:"id"`
+	TaskID      string `json:"task_id"`
+	UploadedBy  string `json:"uploaded_by"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	ObjectKey   string `json:"object_key"`
+}
+
+type GetAttachmentParam struct {
+	ID string `json:"id"`
+}
+
+type ListAttachmentParam struct {
+	TaskID string `json:"task_id"`
+}
+
+type DeleteAttachmentParam struct {
+	ID string `json:"id"`
+}
+
+type ListAttachmentOrphanParam struct {
+	Limit int32 `json:"limit"`
+}
+
+type DeleteAttachmentOrphanParam struct {
+	ObjectKeys []string `json:"object_keys"`
+}
+
+type PresignUploadParam struct {
+	ObjectKey   string `json:"object_key"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+// PresignDownloadParam makes the download be saved as Filename.
+type PresignDownloadParam struct {
+	ObjectKey string `json:"object_key"`
+	Filename  string `json:"filename"`
+}
+
+type CreateTaskHistoryParam struct {
+	TaskID  string            `json:"task_id"`
+	ActorID string            `json:"actor_id"`
+	Action  TaskHistoryAction `json:"action"`
+	Changes []FieldChange     `json:"changes"`
+}
+
+// ListTaskHistoryParam lists the history of a task newest first, starting
+// below BeforeID unless it is zero.
+type ListTaskHistoryParam struct {
+	TaskID   string `json:"task_id"`
+	Limit    int32  `json:"limit"`
+	BeforeID int64  `json:"before_id"`
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// positionDigits are the digits of task positions in ascending byte order, so
+// that positions compare as plain strings.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+var ErrPositionOrder = errors.New("positions are not in order")
+
+// PositionBetween returns a position that sorts after lower and before upper.
+// Positions are fractions written in base 62 without the leading "0.", which
+// leaves room for a new position between any two others. An empty lower is
+// the start of the list and an empty upper its end.
+func PositionBetween(lower, upper string) (string, error) {
+	if upper != "" && lower >= upper {
+		return "", ErrPositionOrder
+	}
+	if !validPosition(lower) || !validPosition(upper) {
+		return "", ErrPositionOrder
+	}
+	return midpoint(lower, upper), nil
+}
+
+// validPosition reports whether p only has position digits and does not end
+// in a zero, which would make it equal to the shorter position.
+func validPosition(p string) bool {
+	for i := 0; i < len(p); i++ {
+		if strings.IndexByte(positionDigits, p[i]) < 0 {
+			return false
+		}
+	}
+	return p == "" || p[len(p)-1] != positionDigits[0]
+}
+
+func midpoint(lower, upper string) string {
+	// 共通の先頭部分はそのまま残す
+	if upper != "" {
+		n := 0
+		for n < len(upper) && positionDigit(lower, n) == upper[n] {
+			n++
+		}
+		if n > 0 {
+			return upper[:n] + midpoint(positionTail(lower, n), upper[n:])
+		}
+	}
+
+	low := strings.IndexByte(positionDigits, positionDigit(lower, 0))
+	high := len(positionDigits)
+	if upper != "" {
+		high = strings.IndexByte(positionDigits, upper[0])
+	}
+	if high-low > 1 {
+		return string(positionDigits[(low+high)/2])
+	}
+	// 隣り合う桁の間には次の桁で挟み込む
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+	return string(positionDigits[low]) + midpoint(positionTail(lower, 1), "")
+}
+
+func positionDigit(p string, i int) byte {
+	if i < len(p) {
+		return p[i]
+	}
+	return positionDigits[0]
+}
+
+func positionTail(p string, i int) string {
+	if i < len(p) {
+		return p[i:]
+	}
+	return ""
+}
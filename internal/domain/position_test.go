@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPositionBetween(t *testing.T) {
+	tests := []struct {
+		name  string
+		lower string
+		upper string
+	}{
+		{name: "正常系_空のリスト", lower: "", upper: ""},
+		{name: "正常系_先頭", lower: "", upper: "V"},
+		{name: "正常系_末尾", lower: "V", upper: ""},
+		{name: "正常系_離れた位置", lower: "A", upper: "z"},
+		{name: "正常系_隣り合う桁", lower: "V", upper: "W"},
+		{name: "正常系_前方一致", lower: "V", upper: "V1"},
+		{name: "正常系_末尾の桁", lower: "zz", upper: ""},
+		{name: "正常系_先頭の桁", lower: "", upper: "0001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PositionBetween(tt.lower, tt.upper)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got <= tt.lower || (tt.upper != "" && got >= tt.upper) {
+				t.Errorf("expected position between %q and %q, got %q", tt.lower, tt.upper, got)
+			}
+		})
+	}
+
+	t.Run("正常系_同じ位置への繰り返しの挿入", func(t *testing.T) {
+		lower, upper := "V", "W"
+		for i := 0; i < 100; i++ {
+			got, err := PositionBetween(lower, upper)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got <= lower || got >= upper {
+				t.Fatalf("expected position between %q and %q, got %q", lower, upper, got)
+			}
+			upper = got
+		}
+	})
+
+	t.Run("異常系_順序が逆または不正な位置", func(t *testing.T) {
+		for _, p := range [][2]string{{"W", "V"}, {"V", "V"}, {"V0", "W"}, {"V", "W-"}} {
+			if _, err := PositionBetween(p[0], p[1]); !errors.Is(err, ErrPositionOrder) {
+				t.Errorf("expected position order error for %v, got %v", p, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type apiKeyRepo struct {
+	db *sql.DB
+}
+
+type APIKeyRepo interface {
+	CreateAPIKey(ctx context.Context, arg domain.CreateAPIKeyParam) error
+	GetAPIKeyByHash(ctx context.Context, arg domain.GetAPIKeyByHashParam) (*domain.APIKey, error)
+	ListAPIKey(ctx context.Context, arg domain.ListAPIKeyParam) ([]domain.APIKey, error)
+	DeleteAPIKey(ctx context.Context, arg domain.DeleteAPIKeyParam) error
+}
+
+func NewAPIKeyRepo(db *sql.DB) APIKeyRepo {
+	return &apiKeyRepo{db: db}
+}
+
+func (a *apiKeyRepo) CreateAPIKey(ctx context.Context, arg domain.CreateAPIKeyParam) error {
+	const query = `INSERT INTO api_key (id, user_id, name, key_prefix, key_hash) VALUES ($1,$2,$3,$4,$5)`
+
+	_, err := a.db.ExecContext(ctx, query, arg.ID, arg.UserID, arg.Name, arg.KeyPrefix, arg.KeyHash)
+
+	return handleError(err, "api_key")
+}
+
+func (a *apiKeyRepo) GetAPIKeyByHash(ctx context.Context, arg domain.GetAPIKeyByHashParam) (*domain.APIKey, error) {
+	const query = `SELECT id, user_id, name, key_prefix, created_at FROM api_key WHERE key_hash = $1`
+
+	row := a.db.QueryRowContext(ctx, query, arg.KeyHash)
+
+	var apiKey domain.APIKey
+	if err := row.Scan(&apiKey.ID, &apiKey.UserID, &apiKey.Name, &apiKey.KeyPrefix, &apiKey.CreatedAt); err != nil {
+		return nil, handleError(err, "api_key")
+	}
+	return &apiKey, nil
+}
+
+func (a *apiKeyRepo) ListAPIKey(ctx context.Context, arg domain.ListAPIKeyParam) ([]domain.APIKey, error) {
+	const query = `SELECT id, user_id, name, key_prefix, created_at FROM api_key WHERE user_id = $1 ORDER BY id`
+
+	rows, err := a.db.QueryContext(ctx, query, arg.UserID)
+	if err != nil {
+		return nil, handleError(err, "api_key")
+	}
+	defer rows.Close()
+
+	var apiKeys []domain.APIKey
+	for rows.Next() {
+		var apiKey domain.APIKey
+		if err := rows.Scan(&apiKey.ID, &apiKey.UserID, &apiKey.Name, &apiKey.KeyPrefix, &apiKey.CreatedAt); err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return apiKeys, nil
+}
+
+func (a *apiKeyRepo) DeleteAPIKey(ctx context.Context, arg domain.DeleteAPIKeyParam) error {
+	const query = `DELETE FROM api_key WHERE id = $1 AND user_id = $2`
+
+	row, err := a.db.ExecContext(ctx, query, arg.ID, arg.UserID)
+	if err != nil {
+		return handleError(err, "api_key")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("api_key", sql.ErrNoRows)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type attachmentRepo struct {
+	db *sql.DB
+}
+
+type AttachmentRepo interface {
+	CreateAttachment(ctx context.Context, arg domain.CreateAttachmentParam) error
+	GetAttachment(ctx context.Context, arg domain.GetAttachmentParam) (*domain.Attachment, error)
+	ListAttachment(ctx context.Context, arg domain.ListAttachmentParam) ([]domain.Attachment, error)
+	// DeleteAttachment deletes the metadata of an attachment and queues its
+	// object for deletion, as deleting a task does for its attachments.
+	DeleteAttachment(ctx context.Context, arg domain.DeleteAttachmentParam) error
+	// ListAttachmentOrphan locks the object keys queued for deletion that no
+	// other transaction is deleting yet.
+	ListAttachmentOrphan(ctx context.Context, tx *sql.Tx, arg domain.ListAttachmentOrphanParam) ([]string, error)
+	DeleteAttachmentOrphan(ctx context.Context, tx *sql.Tx, arg domain.DeleteAttachmentOrphanParam) error
+}
+
+func NewAttachmentRepo(db *sql.DB) AttachmentRepo {
+	return &attachmentRepo{db: db}
+}
+
+const attachmentColumns = `id, task_id, uploaded_by, filename, content_type, size, object_key, created_at`
+
+func scanAttachment(row rowScanner) (domain.Attachment, error) {
+	var attachment domain.Attachment
+	var uploadedBy sql.NullString
+	err := row.Scan(&attachment.ID, &attachment.TaskID, &uploadedBy, &attachment.Filename, &attachment.ContentType, &attachment.Size, &attachment.ObjectKey, &attachment.CreatedAt)
+	attachment.UploadedBy = uploadedBy.String
+	return attachment, err
+}
+
+func (a *attachmentRepo) CreateAttachment(ctx context.Context, arg domain.CreateAttachmentParam) error {
+	const query = `INSERT INTO attachment (id, task_id, uploaded_by, filename, content_type, size, object_key) VALUES ($1,$2,$3,$4,$5,$6,$7)`
+
+	_, err := a.db.ExecContext(ctx, query, arg.ID, arg.TaskID, arg.UploadedBy, arg.Filename, arg.ContentType, arg.Size, arg.ObjectKey)
+
+	return handleError(err, "attachment")
+}
+
+func (a *attachmentRepo) GetAttachment(ctx context.Context, arg domain.GetAttachmentParam) (*domain.Attachment, error) {
+	const query = `SELECT ` + attachmentColumns + ` FROM attachment WHERE id = $1`
+
+	attachment, err := scanAttachment(a.db.QueryRowContext(ctx, query, arg.ID))
+	if err != nil {
+		return nil, handleError(err, "attachment")
+	}
+	return &attachment, nil
+}
+
+func (a *attachmentRepo) ListAttachment(ctx context.Context, arg domain.ListAttachmentParam) ([]domain.Attachment, error) {
+	const query = `SELECT ` + attachmentColumns + ` FROM attachment WHERE task_id = $1 ORDER BY id`
+
+	rows, err := a.db.QueryContext(ctx, query, arg.TaskID)
+	if err != nil {
+		return nil, handleError(err, "attachment")
+	}
+	defer rows.Close()
+
+	var attachments []domain.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, rows.Err()
+}
+
+func (a *attachmentRepo) DeleteAttachment(ctx context.Context, arg domain.DeleteAttachmentParam) error {
+	const query = `DELETE FROM attachment WHERE id = $1`
+
+	row, err := a.db.ExecContext(ctx, query, arg.ID)
+	if err != nil {
+		return handleError(err, "attachment")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("attachment", sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (a *attachmentRepo) ListAttachmentOrphan(ctx context.Context, tx *sql.Tx, arg domain.ListAttachmentOrphanParam) ([]string, error) {
+	// 他のレプリカが削除中のものは飛ばす
+	const query = `SELECT object_key FROM attachment_orphan ORDER BY deleted_at LIMIT $1 FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, arg.Limit)
+	if err != nil {
+		return nil, handleError(err, "attachment_orphan")
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (a *attachmentRepo) DeleteAttachmentOrphan(ctx context.Context, tx *sql.Tx, arg domain.DeleteAttachmentOrphanParam) error {
+	const query = `DELETE FROM attachment_orphan WHERE object_key = ANY($1)`
+
+	_, err := tx.ExecContext(ctx, query, pq.Array(arg.ObjectKeys))
+
+	return handleError(err, "attachment_orphan")
+}
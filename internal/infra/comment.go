@@ -0,0 +1,163 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type commentRepo struct {
+	db *sql.DB
+}
+
+type CommentRepo interface {
+	CreateComment(ctx context.Context, arg domain.CreateCommentParam) error
+	GetComment(ctx context.Context, arg domain.GetCommentParam) (*domain.Comment, error)
+	ListComment(ctx context.Context, arg domain.ListCommentParam) ([]domain.Comment, error)
+	CountComment(ctx context.Context, arg domain.ListCommentParam) (int32, error)
+	// ListCommentRevision returns the revisions of every given comment keyed
+	// by comment ID, oldest first.
+	ListCommentRevision(ctx context.Context, arg domain.ListCommentRevisionParam) (map[string][]domain.CommentRevision, error)
+	UpdateComment(ctx context.Context, arg domain.UpdateCommentParam) error
+	DeleteComment(ctx context.Context, arg domain.DeleteCommentParam) error
+}
+
+func NewCommentRepo(db *sql.DB) CommentRepo {
+	return &commentRepo{db: db}
+}
+
+const commentColumns = `id, task_id, author_id, body, created_at, edited_at`
+
+func scanComment(row rowScanner) (domain.Comment, error) {
+	var comment domain.Comment
+	var authorID sql.NullString
+	var editedAt sql.NullTime
+	err := row.Scan(&comment.ID, &comment.TaskID, &authorID, &comment.Body, &comment.CreatedAt, &editedAt)
+	comment.AuthorID = authorID.String
+	if editedAt.Valid {
+		comment.EditedAt = &editedAt.Time
+	}
+	return comment, err
+}
+
+func (c *commentRepo) CreateComment(ctx context.Context, arg domain.CreateCommentParam) error {
+	const query = `INSERT INTO comment (id, task_id, author_id, body) VALUES ($1,$2,$3,$4)`
+
+	_, err := c.db.ExecContext(ctx, query, arg.ID, arg.TaskID, arg.AuthorID, arg.Body)
+
+	return handleError(err, "comment")
+}
+
+func (c *commentRepo) GetComment(ctx context.Context, arg domain.GetCommentParam) (*domain.Comment, error) {
+	const query = `SELECT ` + commentColumns + ` FROM comment WHERE id = $1`
+
+	comment, err := scanComment(c.db.QueryRowContext(ctx, query, arg.ID))
+	if err != nil {
+		return nil, handleError(err, "comment")
+	}
+	return &comment, nil
+}
+
+func (c *commentRepo) ListComment(ctx context.Context, arg domain.ListCommentParam) ([]domain.Comment, error) {
+	if arg.Limit == 0 {
+		arg.Limit = 100
+	}
+	// IDはUUIDv7のため、ID順は投稿順になる
+	const query = `SELECT ` + commentColumns + ` FROM comment WHERE task_id = $1 AND id > $2 ORDER BY id LIMIT $3`
+
+	rows, err := c.db.QueryContext(ctx, query, arg.TaskID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, handleError(err, "comment")
+	}
+	defer rows.Close()
+
+	var comments []domain.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
+
+func (c *commentRepo) CountComment(ctx context.Context, arg domain.ListCommentParam) (int32, error) {
+	const query = `SELECT count(*) FROM comment WHERE task_id = $1`
+
+	var count int32
+	if err := c.db.QueryRowContext(ctx, query, arg.TaskID).Scan(&count); err != nil {
+		return 0, handleError(err, "comment")
+	}
+	return count, nil
+}
+
+func (c *commentRepo) ListCommentRevision(ctx context.Context, arg domain.ListCommentRevisionParam) (map[string][]domain.CommentRevision, error) {
+	const query = `SELECT comment_id, body, created_at FROM comment_revision WHERE comment_id = ANY($1) ORDER BY id`
+
+	revisions := make(map[string][]domain.CommentRevision, len(arg.CommentIDs))
+	if len(arg.CommentIDs) == 0 {
+		return revisions, nil
+	}
+	rows, err := c.db.QueryContext(ctx, query, pq.Array(arg.CommentIDs))
+	if err != nil {
+		return nil, handleError(err, "comment_revision")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var commentID string
+		var revision domain.CommentRevision
+		if err := rows.Scan(&commentID, &revision.Body, &revision.CreatedAt); err != nil {
+			return nil, err
+		}
+		revisions[commentID] = append(revisions[commentID], revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (c *commentRepo) UpdateComment(ctx context.Context, arg domain.UpdateCommentParam) error {
+	// 更新と編集前の本文の保存を1つの文で行い、同時の編集でも履歴が欠けないようにする
+	const query = `WITH previous AS (
+		SELECT id, body, COALESCE(edited_at, created_at) AS written_at FROM comment WHERE id = $1 FOR UPDATE
+	), updated AS (
+		UPDATE comment SET body = $2, edited_at = CURRENT_TIMESTAMP FROM previous WHERE comment.id = previous.id
+		RETURNING previous.id, previous.body, previous.written_at
+	)
+	INSERT INTO comment_revision (comment_id, body, created_at) SELECT id, body, written_at FROM updated`
+
+	row, err := c.db.ExecContext(ctx, query, arg.ID, arg.Body)
+	if err != nil {
+		return handleError(err, "comment")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("comment", sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (c *commentRepo) DeleteComment(ctx context.Context, arg domain.DeleteCommentParam) error {
+	const query = `DELETE FROM comment WHERE id = $1`
+
+	row, err := c.db.ExecContext(ctx, query, arg.ID)
+	if err != nil {
+		return handleError(err, "comment")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("comment", sql.ErrNoRows)
+	}
+	return nil
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type Connection interface {
@@ -15,7 +16,8 @@ type Connection interface {
 }
 
 type PostgresConnection struct {
-	db *sql.DB
+	db  *sql.DB
+	dsn string
 }
 
 func NewPostgresConnection(
@@ -43,7 +45,7 @@ func NewPostgresConnection(
 	db.SetMaxIdleConns(25)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	return &PostgresConnection{db: db}, nil
+	return &PostgresConnection{db: db, dsn: dsn}, nil
 }
 
 func (p *PostgresConnection) Connection() (*sql.DB, error) {
@@ -53,6 +55,16 @@ func (p *PostgresConnection) Connection() (*sql.DB, error) {
 	return p.db, nil
 }
 
+// Listener opens a dedicated connection for LISTEN/NOTIFY, which cannot share
+// the pooled connections.
+func (p *PostgresConnection) Listener() *pq.Listener {
+	return pq.NewListener(p.dsn, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("postgres listener: %v", err)
+		}
+	})
+}
+
 func (p *PostgresConnection) Close(ctx context.Context) error {
 	if err := p.db.Close(); err != nil {
 		return fmt.Errorf("failed to close database connection: %w", err)
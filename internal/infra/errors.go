@@ -0,0 +1,87 @@
+package infra
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+// PostgreSQL error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pqNotNullViolation          = "23502"
+	pqForeignKeyViolation       = "23503"
+	pqUniqueViolation           = "23505"
+	pqCheckViolation            = "23514"
+	pqInvalidTextRepresentation = "22P02"
+	pqInvalidDatetimeFormat     = "22007"
+	pqDatetimeFieldOverflow     = "22008"
+	pqSerializationFailure      = "40001"
+	pqDeadlockDetected          = "40P01"
+)
+
+// constraintFields maps the constraints whose violation clients can cause to
+// the request field they come from. The names of other constraints are not
+// reported, so that the schema does not leak into responses.
+var constraintFields = map[string]string{
+	"task_tag_tag_id_fkey":               "tag_ids",
+	"task_tag_tag_id_fkey1":              "tag_ids",
+	"task_parent_id_fkey":                "parent_id",
+	"task_project_id_fkey":               "project_id",
+	"tag_project_id_fkey":                "project_id",
+	"task_dependency_blocked_by_id_fkey": "blocked_by_id",
+	"task_dependency_pkey":               "blocked_by_id",
+	"tag_owner_id_name_lower_key":        "name",
+	"tag_project_id_name_lower_key":      "name",
+	"users_email_lower_key":              "email",
+	"task_parent_id_check":               "parent_id",
+	"task_dependency_self_check":         "blocked_by_id",
+	"task_priority_check":                "priority",
+	"project_member_role_check":          "role",
+}
+
+// handleError converts driver errors into domain errors. Errors that have no
+// domain meaning are returned unchanged.
+func handleError(err error, resource string) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.NewNotFoundError(resource, err)
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+	switch pqErr.Code {
+	// 制約名やDetailには列の値やスキーマが含まれるため、クライアントには返さない
+	case pqUniqueViolation:
+		return domain.NewAlreadyExistsError(resource, constraintFields[pqErr.Constraint], err)
+	case pqForeignKeyViolation:
+		e := domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", "a referenced resource does not exist")
+		e.Resource = resource
+		e.Field = constraintFields[pqErr.Constraint]
+		e.Err = err
+		return e
+	case pqNotNullViolation:
+		e := domain.NewInvalidArgumentError(pqErr.Column, "a required value is missing")
+		e.Resource = resource
+		e.Err = err
+		return e
+	case pqCheckViolation:
+		e := domain.NewInvalidArgumentError(constraintFields[pqErr.Constraint], "the value is out of range")
+		e.Resource = resource
+		e.Err = err
+		return e
+	case pqInvalidTextRepresentation, pqInvalidDatetimeFormat, pqDatetimeFieldOverflow:
+		e := domain.NewInvalidArgumentError("", pqErr.Message)
+		e.Resource = resource
+		e.Err = err
+		return e
+	case pqSerializationFailure, pqDeadlockDetected:
+		return domain.NewConflictError(resource, err)
+	}
+	return err
+}
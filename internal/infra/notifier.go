@@ -0,0 +1,194 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+// Notifier delivers reminders to the owner of a task. A reminder whose
+// delivery fails is retried later.
+type Notifier interface {
+	Notify(ctx context.Context, reminder *domain.Reminder) error
+}
+
+// reminderSubject returns a one line summary of reminder.
+func reminderSubject(reminder *domain.Reminder) string {
+	if reminder.Overdue() {
+		return fmt.Sprintf("%s is overdue", reminder.Title)
+	}
+	return fmt.Sprintf("%s is due in %s", reminder.Title, reminder.Before)
+}
+
+type logNotifier struct{}
+
+// NewLogNotifier writes reminders to the standard logger, which is useful
+// during development.
+func NewLogNotifier() Notifier {
+	return logNotifier{}
+}
+
+func (logNotifier) Notify(ctx context.Context, reminder *domain.Reminder) error {
+	log.Printf("reminder for %s: %s (task %s)", reminder.Email, reminderSubject(reminder), reminder.TaskID)
+	return nil
+}
+
+type smtpNotifier struct {
+	host    string
+	addr    string
+	auth    smtp.Auth
+	from    string
+	timeout time.Duration
+}
+
+// NewSMTPNotifier mails reminders through the server at host:port. Plain
+// authentication is used when username is set. A delivery that takes longer
+// than timeout fails.
+func NewSMTPNotifier(host string, port int, username, password, from string, timeout time.Duration) Notifier {
+	n := &smtpNotifier{host: host, addr: net.JoinHostPort(host, strconv.Itoa(port)), from: from, timeout: timeout}
+	if username != "" {
+		n.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return n
+}
+
+func (n *smtpNotifier) Notify(ctx context.Context, reminder *domain.Reminder) error {
+	// タイトルに改行が含まれてもヘッダーを壊さないようエンコードする
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", reminder.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", reminderSubject(reminder)))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	fmt.Fprintf(&msg, "%s\r\nDue: %s\r\n", strings.ReplaceAll(reminder.Title, "\n", "\r\n"), reminder.LimitedAt.Format(time.RFC3339))
+
+	// smtp.SendMailはctxもタイムアウトも扱わないため、接続から自分で行う
+	ctx, cancel := context.WithTimeout(ctx, n.timeout)
+	defer cancel()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	// キャンセルされたら接続を閉じて読み書きを止める
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	if err := n.send(conn, reminder.Email, msg.String()); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+// send does what smtp.SendMail does over conn.
+func (n *smtpNotifier) send(conn net.Conn, to, msg string) error {
+	c, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return err
+		}
+	}
+	if n.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := c.Auth(n.auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(n.from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte(msg)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier posts reminders as JSON to url. Any response other than
+// 2xx counts as a failed delivery.
+func NewWebhookNotifier(url string, client *http.Client) Notifier {
+	return &webhookNotifier{url: url, client: client}
+}
+
+type webhookReminder struct {
+	TaskID    string    `json:"task_id"`
+	Title     string    `json:"title"`
+	LimitedAt time.Time `json:"limited_at"`
+	// RemindBefore is in seconds and zero for overdue tasks.
+	RemindBefore int64  `json:"remind_before"`
+	Overdue      bool   `json:"overdue"`
+	UserID       string `json:"user_id"`
+	Email        string `json:"email"`
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, reminder *domain.Reminder) error {
+	body, err := json.Marshal(webhookReminder{
+		TaskID:       reminder.TaskID,
+		Title:        reminder.Title,
+		LimitedAt:    reminder.LimitedAt,
+		RemindBefore: int64(reminder.Before / time.Second),
+		Overdue:      reminder.Overdue(),
+		UserID:       reminder.UserID,
+		Email:        reminder.Email,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+	return nil
+}
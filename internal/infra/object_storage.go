@@ -0,0 +1,103 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+// ObjectStorage stores the contents of attachments. Clients upload and
+// download objects directly through presigned URLs.
+type ObjectStorage interface {
+	PresignUpload(ctx context.Context, arg domain.PresignUploadParam) (*domain.PresignedURL, error)
+	PresignDownload(ctx context.Context, arg domain.PresignDownloadParam) (*domain.PresignedURL, error)
+	// DeleteObjects deletes the objects with the given keys. Keys without an
+	// object are ignored.
+	DeleteObjects(ctx context.Context, keys []string) error
+}
+
+type s3Storage struct {
+	client  *s3.Client
+	presign *s3.PresignClient
+	bucket  string
+	ttl     time.Duration
+}
+
+// NewS3Storage stores objects in bucket of an S3 compatible storage such as
+// R2 or MinIO. Presigned URLs are valid for ttl.
+func NewS3Storage(client *s3.Client, bucket string, ttl time.Duration) ObjectStorage {
+	return &s3Storage{
+		client:  client,
+		presign: s3.NewPresignClient(client, s3.WithPresignExpires(ttl)),
+		bucket:  bucket,
+		ttl:     ttl,
+	}
+}
+
+func (s *s3Storage) PresignUpload(ctx context.Context, arg domain.PresignUploadParam) (*domain.PresignedURL, error) {
+	// Content-TypeとContent-Lengthも署名に含め、申告と異なるファイルはアップロードできないようにする
+	expiresAt := time.Now().Add(s.ttl)
+	req, err := s.presign.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(arg.ObjectKey),
+		ContentType:   aws.String(arg.ContentType),
+		ContentLength: aws.Int64(arg.Size),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPresignedURL(req.URL, req.SignedHeader, expiresAt), nil
+}
+
+func (s *s3Storage) PresignDownload(ctx context.Context, arg domain.PresignDownloadParam) (*domain.PresignedURL, error) {
+	expiresAt := time.Now().Add(s.ttl)
+	req, err := s.presign.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket:                     aws.String(s.bucket),
+		Key:                        aws.String(arg.ObjectKey),
+		ResponseContentDisposition: aws.String(mime.FormatMediaType("attachment", map[string]string{"filename": arg.Filename})),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPresignedURL(req.URL, req.SignedHeader, expiresAt), nil
+}
+
+func (s *s3Storage) DeleteObjects(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	objects := make([]types.ObjectIdentifier, len(keys))
+	for i, key := range keys {
+		objects[i] = types.ObjectIdentifier{Key: aws.String(key)}
+	}
+	res, err := s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		Bucket: aws.String(s.bucket),
+		Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+	})
+	if err != nil {
+		return err
+	}
+	if len(res.Errors) > 0 {
+		e := res.Errors[0]
+		return fmt.Errorf("failed to delete %d objects, first %s: %s", len(res.Errors), aws.ToString(e.Key), aws.ToString(e.Message))
+	}
+	return nil
+}
+
+func toPresignedURL(url string, signed http.Header, expiresAt time.Time) *domain.PresignedURL {
+	// Hostはクライアントが自動で付けるため返さない
+	header := map[string]string{}
+	for name := range signed {
+		if name != "Host" {
+			header[name] = signed.Get(name)
+		}
+	}
+	return &domain.PresignedURL{URL: url, Header: header, ExpiresAt: expiresAt}
+}
@@ -0,0 +1,184 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type projectRepo struct {
+	db *sql.DB
+}
+
+type ProjectRepo interface {
+	CreateProject(ctx context.Context, tx *sql.Tx, arg domain.CreateProjectParam) error
+	GetProject(ctx context.Context, arg domain.GetProjectParam) (*domain.Project, error)
+	ListProject(ctx context.Context, arg domain.ListProjectParam) ([]domain.Project, error)
+	CountProject(ctx context.Context, arg domain.ListProjectParam) (int32, error)
+	RenameProject(ctx context.Context, arg domain.RenameProjectParam) error
+	ArchiveProject(ctx context.Context, arg domain.ArchiveProjectParam) error
+	CreateProjectMember(ctx context.Context, tx *sql.Tx, arg domain.CreateProjectMemberParam) error
+	ListProjectMember(ctx context.Context, arg domain.ListProjectMemberParam) ([]domain.ProjectMember, error)
+	DeleteProjectMember(ctx context.Context, tx *sql.Tx, arg domain.DeleteProjectMemberParam) error
+}
+
+func NewProjectRepo(db *sql.DB) ProjectRepo {
+	return &projectRepo{db: db}
+}
+
+// 取得したユーザーのロールも合わせて返す
+const projectColumns = `project.id, project.owner_id, project.name, project_member.role, project.archived_at, project.created_at, project.updated_at`
+
+const projectJoinMember = ` FROM project JOIN project_member ON project_member.project_id = project.id AND project_member.user_id = $2`
+
+// メンバーでないプロジェクトは存在しないものとして扱う
+const projectMemberCondition = `id IN (SELECT project_id FROM project_member WHERE user_id = $2)`
+
+func scanProject(row rowScanner) (domain.Project, error) {
+	var project domain.Project
+	var archivedAt sql.NullTime
+	err := row.Scan(&project.ID, &project.OwnerID, &project.Name, &project.Role, &archivedAt, &project.CreatedAt, &project.UpdatedAt)
+	if archivedAt.Valid {
+		project.ArchivedAt = &archivedAt.Time
+	}
+	return project, err
+}
+
+func (p *projectRepo) CreateProject(ctx context.Context, tx *sql.Tx, arg domain.CreateProjectParam) error {
+	const query = `INSERT INTO project (id, owner_id, name) VALUES ($1,$2,$3)`
+
+	_, err := tx.ExecContext(ctx, query, arg.ID, arg.OwnerID, arg.Name)
+
+	return handleError(err, "project")
+}
+
+func (p *projectRepo) GetProject(ctx context.Context, arg domain.GetProjectParam) (*domain.Project, error) {
+	const query = `SELECT ` + projectColumns + projectJoinMember + ` WHERE project.id = $1`
+
+	project, err := scanProject(p.db.QueryRowContext(ctx, query, arg.ID, arg.UserID))
+	if err != nil {
+		return nil, handleError(err, "project")
+	}
+	return &project, nil
+}
+
+func (p *projectRepo) ListProject(ctx context.Context, arg domain.ListProjectParam) ([]domain.Project, error) {
+	const query = `SELECT ` + projectColumns + projectJoinMember +
+		` WHERE project.id > $1 AND ($3 OR project.archived_at IS NULL) ORDER BY project.id LIMIT $4 OFFSET $5`
+
+	if arg.Limit == 0 {
+		arg.Limit = 100
+	}
+	rows, err := p.db.QueryContext(ctx, query, arg.AfterID, arg.UserID, arg.IncludeArchived, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []domain.Project
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+func (p *projectRepo) CountProject(ctx context.Context, arg domain.ListProjectParam) (int32, error) {
+	const query = `SELECT count(*) FROM project WHERE ` + projectMemberCondition + ` AND ($1 OR archived_at IS NULL)`
+	var count int32
+	if err := p.db.QueryRowContext(ctx, query, arg.IncludeArchived, arg.UserID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (p *projectRepo) RenameProject(ctx context.Context, arg domain.RenameProjectParam) error {
+	const query = `UPDATE project SET name = $3 WHERE id = $1 AND ` + projectMemberCondition
+
+	row, err := p.db.ExecContext(ctx, query, arg.ID, arg.UserID, arg.Name)
+	if err != nil {
+		return handleError(err, "project")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("project", sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (p *projectRepo) ArchiveProject(ctx context.Context, arg domain.ArchiveProjectParam) error {
+	// アーカイブ済みのプロジェクトはアーカイブ日時を変えない
+	const query = `UPDATE project SET archived_at = COALESCE(archived_at, CURRENT_TIMESTAMP) WHERE id = $1 AND ` + projectMemberCondition
+
+	row, err := p.db.ExecContext(ctx, query, arg.ID, arg.UserID)
+	if err != nil {
+		return handleError(err, "project")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("project", sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (p *projectRepo) CreateProjectMember(ctx context.Context, tx *sql.Tx, arg domain.CreateProjectMemberParam) error {
+	const query = `INSERT INTO project_member (project_id, user_id, role) VALUES ($1,$2,$3)`
+
+	_, err := tx.ExecContext(ctx, query, arg.ProjectID, arg.UserID, arg.Role)
+
+	return handleError(err, "project_member")
+}
+
+func (p *projectRepo) ListProjectMember(ctx context.Context, arg domain.ListProjectMemberParam) ([]domain.ProjectMember, error) {
+	const query = `SELECT project_id, user_id, role, created_at FROM project_member WHERE project_id = $1 ORDER BY created_at, user_id`
+
+	rows, err := p.db.QueryContext(ctx, query, arg.ProjectID)
+	if err != nil {
+		return nil, handleError(err, "project_member")
+	}
+	defer rows.Close()
+
+	var members []domain.ProjectMember
+	for rows.Next() {
+		var member domain.ProjectMember
+		if err := rows.Scan(&member.ProjectID, &member.UserID, &member.Role, &member.CreatedAt); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (p *projectRepo) DeleteProjectMember(ctx context.Context, tx *sql.Tx, arg domain.DeleteProjectMemberParam) error {
+	const query = `DELETE FROM project_member WHERE project_id = $1 AND user_id = $2`
+
+	row, err := tx.ExecContext(ctx, query, arg.ProjectID, arg.UserID)
+	if err != nil {
+		return handleError(err, "project_member")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("project_member", sql.ErrNoRows)
+	}
+	return nil
+}
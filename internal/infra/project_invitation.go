@@ -0,0 +1,89 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type projectInvitationRepo struct {
+	db *sql.DB
+}
+
+type ProjectInvitationRepo interface {
+	CreateProjectInvitation(ctx context.Context, arg domain.CreateProjectInvitationParam) error
+	GetProjectInvitation(ctx context.Context, arg domain.GetProjectInvitationParam) (*domain.ProjectInvitation, error)
+	ListProjectInvitation(ctx context.Context, arg domain.ListProjectInvitationParam) ([]domain.ProjectInvitation, error)
+	DeleteProjectInvitation(ctx context.Context, tx *sql.Tx, arg domain.DeleteProjectInvitationParam) error
+}
+
+func NewProjectInvitationRepo(db *sql.DB) ProjectInvitationRepo {
+	return &projectInvitationRepo{db: db}
+}
+
+func scanProjectInvitation(row rowScanner) (domain.ProjectInvitation, error) {
+	var invitation domain.ProjectInvitation
+	var invitedBy sql.NullString
+	err := row.Scan(&invitation.ID, &invitation.ProjectID, &invitation.UserID, &invitation.Role, &invitedBy, &invitation.CreatedAt)
+	invitation.InvitedBy = invitedBy.String
+	return invitation, err
+}
+
+func (p *projectInvitationRepo) CreateProjectInvitation(ctx context.Context, arg domain.CreateProjectInvitationParam) error {
+	const query = `INSERT INTO project_invitation (id, project_id, user_id, role, invited_by) VALUES ($1,$2,$3,$4,$5)`
+
+	_, err := p.db.ExecContext(ctx, query, arg.ID, arg.ProjectID, arg.UserID, arg.Role, arg.InvitedBy)
+
+	return handleError(err, "project_invitation")
+}
+
+func (p *projectInvitationRepo) GetProjectInvitation(ctx context.Context, arg domain.GetProjectInvitationParam) (*domain.ProjectInvitation, error) {
+	const query = `SELECT id, project_id, user_id, role, invited_by, created_at FROM project_invitation WHERE id = $1 AND user_id = $2`
+
+	invitation, err := scanProjectInvitation(p.db.QueryRowContext(ctx, query, arg.ID, arg.UserID))
+	if err != nil {
+		return nil, handleError(err, "project_invitation")
+	}
+	return &invitation, nil
+}
+
+func (p *projectInvitationRepo) ListProjectInvitation(ctx context.Context, arg domain.ListProjectInvitationParam) ([]domain.ProjectInvitation, error) {
+	const query = `SELECT id, project_id, user_id, role, invited_by, created_at FROM project_invitation WHERE user_id = $1 ORDER BY id`
+
+	rows, err := p.db.QueryContext(ctx, query, arg.UserID)
+	if err != nil {
+		return nil, handleError(err, "project_invitation")
+	}
+	defer rows.Close()
+
+	var invitations []domain.ProjectInvitation
+	for rows.Next() {
+		invitation, err := scanProjectInvitation(rows)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+func (p *projectInvitationRepo) DeleteProjectInvitation(ctx context.Context, tx *sql.Tx, arg domain.DeleteProjectInvitationParam) error {
+	const query = `DELETE FROM project_invitation WHERE project_id = $1 AND user_id = $2`
+
+	row, err := tx.ExecContext(ctx, query, arg.ProjectID, arg.UserID)
+	if err != nil {
+		return handleError(err, "project_invitation")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("project_invitation", sql.ErrNoRows)
+	}
+	return nil
+}
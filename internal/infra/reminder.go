@@ -0,0 +1,103 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type reminderRepo struct {
+	db *sql.DB
+}
+
+type ReminderRepo interface {
+	ListDueReminder(ctx context.Context, arg domain.ListDueReminderParam) ([]*domain.Reminder, error)
+	// CreateReminder records a reminder as sent. It returns false when it has
+	// already been recorded, by this or another replica.
+	CreateReminder(ctx context.Context, tx *sql.Tx, arg domain.CreateReminderParam) (bool, error)
+	RecordReminderFailure(ctx context.Context, arg domain.RecordReminderFailureParam) error
+}
+
+func NewReminderRepo(db *sql.DB) ReminderRepo {
+	return &reminderRepo{db: db}
+}
+
+func (r *reminderRepo) ListDueReminder(ctx context.Context, arg domain.ListDueReminderParam) ([]*domain.Reminder, error) {
+	// 期限前のリマインダーは期限を過ぎたら送らず、期限切れの通知に任せる
+	// 送信に失敗したリマインダーは再送の時刻まで飛ばし、送るべき時刻が早いものから送る
+	const query = `SELECT due.task_id, due.title, due.limited_at, due.window_before, due.user_id, due.email
+	FROM (
+		SELECT DISTINCT ON (task.id) task.id AS task_id, task.title, task.limited_at, window_before, users.id AS user_id, users.email
+		FROM task
+		JOIN users ON users.id = task.owner_id
+		CROSS JOIN unnest($1::integer[]) AS window_before
+		WHERE NOT task.is_end AND task.deleted_at IS NULL
+		AND task.limited_at <= $2::timestamptz + make_interval(secs => window_before)
+		AND (window_before = 0 OR task.limited_at > $2::timestamptz)
+		AND NOT EXISTS (
+			SELECT 1 FROM reminder
+			WHERE reminder.task_id = task.id AND reminder.limited_at = task.limited_at AND reminder.remind_before <= window_before
+		)
+		ORDER BY task.id, window_before
+	) due
+	WHERE NOT EXISTS (
+		SELECT 1 FROM reminder_failure
+		WHERE reminder_failure.task_id = due.task_id AND reminder_failure.limited_at = due.limited_at
+		AND reminder_failure.remind_before = due.window_before AND reminder_failure.next_attempt_at > $2::timestamptz
+	)
+	ORDER BY due.limited_at - make_interval(secs => due.window_before), due.task_id
+	LIMIT $3`
+
+	windows := make([]int64, len(arg.Windows))
+	for i, w := range arg.Windows {
+		windows[i] = int64(w / time.Second)
+	}
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(windows), arg.Now, arg.Limit)
+	if err != nil {
+		return nil, handleError(err, "reminder")
+	}
+	defer rows.Close()
+
+	var reminders []*domain.Reminder
+	for rows.Next() {
+		var reminder domain.Reminder
+		var before int64
+		if err := rows.Scan(&reminder.TaskID, &reminder.Title, &reminder.LimitedAt, &before, &reminder.UserID, &reminder.Email); err != nil {
+			return nil, err
+		}
+		reminder.Before = time.Duration(before) * time.Second
+		reminders = append(reminders, &reminder)
+	}
+	return reminders, rows.Err()
+}
+
+func (r *reminderRepo) CreateReminder(ctx context.Context, tx *sql.Tx, arg domain.CreateReminderParam) (bool, error) {
+	// 他のレプリカが送信中なら、そのトランザクションが終わるまで待ってから判定される
+	const query = `INSERT INTO reminder (task_id, limited_at, remind_before) VALUES ($1,$2,$3) ON CONFLICT DO NOTHING`
+
+	row, err := tx.ExecContext(ctx, query, arg.TaskID, arg.LimitedAt, int64(arg.Before/time.Second))
+	if err != nil {
+		return false, handleError(err, "reminder")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *reminderRepo) RecordReminderFailure(ctx context.Context, arg domain.RecordReminderFailureParam) error {
+	const query = `INSERT INTO reminder_failure (task_id, limited_at, remind_before, last_error, next_attempt_at)
+	VALUES ($1, $2, $3, $4, $5::timestamptz + make_interval(secs => $6))
+	ON CONFLICT (task_id, limited_at, remind_before) DO UPDATE SET
+		attempts = reminder_failure.attempts + 1,
+		last_error = EXCLUDED.last_error,
+		next_attempt_at = $5::timestamptz + make_interval(secs => least($6 * power(2, reminder_failure.attempts), $7))`
+
+	_, err := r.db.ExecContext(ctx, query, arg.TaskID, arg.LimitedAt, int64(arg.Before/time.Second), arg.Error, arg.FailedAt,
+		arg.Backoff.Seconds(), arg.MaxBackoff.Seconds())
+	return handleError(err, "reminder")
+}
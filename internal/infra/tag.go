@@ -3,6 +3,7 @@ package infra
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/sikigasa/task-controller/internal/domain"
 )
@@ -15,6 +16,8 @@ type TagRepo interface {
 	CreateTag(ctx context.Context, arg domain.CreateTagParam) error
 	GetTag(ctx context.Context, arg domain.GetTagParam) (*domain.Tag, error)
 	ListTag(ctx context.Context, arg domain.ListTagParam) ([]domain.Tag, error)
+	CountTag(ctx context.Context, arg domain.ListTagParam) (int32, error)
+	UpdateTag(ctx context.Context, arg domain.UpdateTagParam) error
 	DeleteTag(ctx context.Context, arg domain.DeleteTagParam) error
 }
 
@@ -22,33 +25,50 @@ func NewTagRepo(db *sql.DB) TagRepo {
 	return &tagRepo{db: db}
 }
 
+// tagVisibleTo matches the personal tags of userID and the tags of the
+// projects they are a member of.
+func tagVisibleTo(userID string, args *queryArgs) string {
+	user := args.add(userID)
+	return fmt.Sprintf("((tag.owner_id = %s AND tag.project_id IS NULL) OR tag.project_id IN (SELECT project_id FROM project_member WHERE user_id = %s))", user, user)
+}
+
+func scanTag(row rowScanner) (domain.Tag, error) {
+	var tag domain.Tag
+	var projectID sql.NullString
+	err := row.Scan(&tag.ID, &projectID, &tag.Name)
+	tag.ProjectID = projectID.String
+	return tag, err
+}
+
 func (t *tagRepo) CreateTag(ctx context.Context, arg domain.CreateTagParam) error {
-	const query = `INSERT INTO Tag (id, name) VALUES ($1,$2)`
+	const query = `INSERT INTO Tag (id, owner_id, project_id, name) VALUES ($1,$2,$3,$4)`
 
-	row := t.db.QueryRowContext(ctx, query, arg.ID, arg.Name)
+	row := t.db.QueryRowContext(ctx, query, arg.ID, arg.OwnerID, nullString(arg.ProjectID), arg.Name)
 
-	return row.Err()
+	return handleError(row.Err(), "tag")
 }
 
 func (t *tagRepo) GetTag(ctx context.Context, arg domain.GetTagParam) (*domain.Tag, error) {
-	const query = `SELECT id, name FROM Tag WHERE id = $1`
-
-	row := t.db.QueryRowContext(ctx, query, arg.ID)
+	var args queryArgs
+	query := `SELECT id, project_id, name FROM Tag WHERE id = ` + args.add(arg.ID) + ` AND ` + tagVisibleTo(arg.UserID, &args)
 
-	var tag domain.Tag
-	if err := row.Scan(&tag.ID, &tag.Name); err != nil {
-		return nil, err
+	tag, err := scanTag(t.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, handleError(err, "tag")
 	}
 	return &tag, nil
 }
 
 func (t *tagRepo) ListTag(ctx context.Context, arg domain.ListTagParam) ([]domain.Tag, error) {
-	const query = `SELECT id, name FROM Tag LIMIT $1 OFFSET $2`
-
 	if arg.Limit == 0 {
 		arg.Limit = 100
 	}
-	rows, err := t.db.QueryContext(ctx, query, arg.Limit, arg.Offset)
+	var args queryArgs
+	query := `SELECT id, project_id, name FROM Tag WHERE ` + tagVisibleTo(arg.UserID, &args) +
+		` AND id > ` + args.add(arg.AfterID) +
+		` ORDER BY id LIMIT ` + args.add(arg.Limit) + ` OFFSET ` + args.add(arg.Offset)
+
+	rows, err := t.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -56,20 +76,61 @@ func (t *tagRepo) ListTag(ctx context.Context, arg domain.ListTagParam) ([]domai
 
 	var tags []domain.Tag
 	for rows.Next() {
-		var tag domain.Tag
-		if err := rows.Scan(&tag.ID, &tag.Name); err != nil {
+		tag, err := scanTag(rows)
+		if err != nil {
 			return nil, err
 		}
 		tags = append(tags, tag)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
 	return tags, nil
 }
 
-func (t *tagRepo) DeleteTag(ctx context.Context, arg domain.DeleteTagParam) error {
-	const query = `DELETE FROM Tag WHERE id = $1`
+func (t *tagRepo) CountTag(ctx context.Context, arg domain.ListTagParam) (int32, error) {
+	var args queryArgs
+	query := `SELECT count(*) FROM Tag WHERE ` + tagVisibleTo(arg.UserID, &args)
+	var count int32
+	if err := t.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (t *tagRepo) UpdateTag(ctx context.Context, arg domain.UpdateTagParam) error {
+	var args queryArgs
+	query := `UPDATE Tag SET name = ` + args.add(arg.Name) + ` WHERE id = ` + args.add(arg.ID) + ` AND ` + tagVisibleTo(arg.UserID, &args)
 
-	row := t.db.QueryRowContext(ctx, query, arg.ID)
+	row, err := t.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return handleError(err, "tag")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("tag", sql.ErrNoRows)
+	}
+	return nil
+}
 
-	return row.Err()
+func (t *tagRepo) DeleteTag(ctx context.Context, arg domain.DeleteTagParam) error {
+	var args queryArgs
+	query := `DELETE FROM Tag WHERE id = ` + args.add(arg.ID) + ` AND ` + tagVisibleTo(arg.UserID, &args)
+
+	row, err := t.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return handleError(err, "tag")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("tag", sql.ErrNoRows)
+	}
+	return nil
 }
@@ -3,7 +3,11 @@ package infra
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/lib/pq"
 	"github.com/sikigasa/task-controller/internal/domain"
 )
 
@@ -15,8 +19,27 @@ type TaskRepo interface {
 	CreateTask(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskParam) error
 	GetTask(ctx context.Context, arg domain.GetTaskParam) (*domain.Task, error)
 	ListTask(ctx context.Context, arg domain.ListTaskParam) ([]domain.Task, error)
+	CountTask(ctx context.Context, arg domain.ListTaskParam) (int32, error)
 	UpdateTask(ctx context.Context, tx *sql.Tx, arg domain.UpdateTaskParam) error
 	DeleteTask(ctx context.Context, tx *sql.Tx, arg domain.DeleteTaskParam) error
+	RestoreTask(ctx context.Context, tx *sql.Tx, arg domain.RestoreTaskParam) error
+	PurgeTask(ctx context.Context, arg domain.PurgeTaskParam) error
+	// PurgeDeletedTask returns how many tasks were deleted, not counting the
+	// subtasks deleted along with them.
+	PurgeDeletedTask(ctx context.Context, arg domain.PurgeDeletedTaskParam) (int64, error)
+	IsTaskDescendant(ctx context.Context, tx *sql.Tx, arg domain.IsTaskDescendantParam) (bool, error)
+	CountOpenSubtask(ctx context.Context, tx *sql.Tx, arg domain.SubtaskParam) (int32, error)
+	// CompleteSubtask returns the previous status of each completed subtask
+	// keyed by task ID.
+	CompleteSubtask(ctx context.Context, tx *sql.Tx, arg domain.CompleteSubtaskParam) (map[string]string, error)
+	TransitionTask(ctx context.Context, tx *sql.Tx, arg domain.TransitionTaskParam) error
+	// SyncTaskEnd returns the tasks whose is_end changed, with the new value.
+	SyncTaskEnd(ctx context.Context, tx *sql.Tx, arg domain.SyncTaskEndParam) ([]domain.Task, error)
+	GetLastTaskPosition(ctx context.Context, tx *sql.Tx, arg domain.TaskScopeParam) (string, error)
+	GetNeighborPosition(ctx context.Context, tx *sql.Tx, arg domain.GetNeighborPositionParam) (string, error)
+	MoveTask(ctx context.Context, tx *sql.Tx, arg domain.MoveTaskParam) error
+	CreateNextOccurrence(ctx context.Context, tx *sql.Tx, arg domain.CreateNextOccurrenceParam) (bool, error)
+	ListTaskProgress(ctx context.Context, arg domain.ListTaskProgressParam) (map[string]domain.TaskProgress, error)
 }
 
 func NewTaskRepo(db *sql.DB) TaskRepo {
@@ -24,34 +47,69 @@ func NewTaskRepo(db *sql.DB) TaskRepo {
 }
 
 func (t *taskRepo) CreateTask(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskParam) error {
-	const query = `INSERT INTO task (id, title, description, limited_at, is_end) VALUES ($1,$2,$3,$4,$5)`
+	// 新しいタスクはワークフローの最初のステータスから始まる
+	const query = `INSERT INTO task (id, owner_id, project_id, parent_id, title, description, limited_at, priority, position, recurrence, recurrence_start, status_id, is_end)
+	SELECT $1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11, id, is_end FROM workflow_status WHERE project_id IS NOT DISTINCT FROM $3 ORDER BY position LIMIT 1`
 
-	_, err := tx.ExecContext(ctx, query, arg.ID, arg.Title, arg.Description, arg.LimitedAt, arg.IsEnd)
-
-	return err
+	row, err := tx.ExecContext(ctx, query, arg.ID, arg.OwnerID, nullString(arg.ProjectID), nullString(arg.ParentID), arg.Title, arg.Description, arg.LimitedAt, arg.Priority, arg.Position,
+		nullString(arg.Recurrence), arg.RecurrenceStart)
+	if err != nil {
+		return handleError(err, "task")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("workflow_status", sql.ErrNoRows)
+	}
+	return nil
 }
 
 func (t *taskRepo) GetTask(ctx context.Context, arg domain.GetTaskParam) (*domain.Task, error) {
-	const query = `SELECT * FROM task WHERE id = $1`
-	row := t.db.QueryRowContext(ctx, query, arg.ID)
-	var task domain.Task
-	if err := row.Scan(&task.ID, &task.Title, &task.Description, &task.CreatedAt, &task.UpdateAt, &task.LimitedAt, &task.IsEnd); err != nil {
-		return nil, err
+	var args queryArgs
+	query := `SELECT ` + taskColumns + ` FROM task WHERE id = ` + args.add(arg.ID) + ` AND ` + taskVisibleTo(arg.UserID, &args)
+	if !arg.IncludeDeleted {
+		query += ` AND deleted_at IS NULL`
+	}
+	task, err := scanTask(t.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, handleError(err, "task")
 	}
 	return &task, nil
 }
 
 func (t *taskRepo) ListTask(ctx context.Context, arg domain.ListTaskParam) ([]domain.Task, error) {
-	const query = `SELECT * FROM task LIMIT $1 OFFSET $2`
-	rows, err := t.db.QueryContext(ctx, query, arg.Limit, arg.Offset)
+	var args queryArgs
+	conds := append([]string{taskVisibleTo(arg.UserID, &args)}, taskFilterConditions(arg.Filter, &args)...)
+
+	order := "id"
+	if column, ok := taskOrderColumns[arg.OrderBy]; ok {
+		dir, cmp := "ASC", ">"
+		if arg.Desc {
+			dir, cmp = "DESC", "<"
+		}
+		if arg.AfterID != "" {
+			conds = append(conds, fmt.Sprintf("(%s, id) %s (%s::%s, %s)", arg.OrderBy, cmp, args.add(arg.AfterValue), column, args.add(arg.AfterID)))
+		}
+		order = fmt.Sprintf("%s %s, id %s", arg.OrderBy, dir, dir)
+	} else if arg.AfterID != "" {
+		conds = append(conds, "id > "+args.add(arg.AfterID))
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM task` +
+		whereClause(conds) +
+		fmt.Sprintf(" ORDER BY %s LIMIT %s OFFSET %s", order, args.add(arg.Limit), args.add(arg.Offset))
+
+	rows, err := t.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, handleError(err, "task")
 	}
 	defer rows.Close()
 	var tasks []domain.Task
 	for rows.Next() {
-		var task domain.Task
-		if err := rows.Scan(&task.ID, &task.Title, &task.Description, &task.CreatedAt, &task.UpdateAt, &task.LimitedAt, &task.IsEnd); err != nil {
+		task, err := scanTask(rows)
+		if err != nil {
 			return nil, err
 		}
 		tasks = append(tasks, task)
@@ -60,27 +118,443 @@ func (t *taskRepo) ListTask(ctx context.Context, arg domain.ListTaskParam) ([]do
 		return nil, err
 	}
 	return tasks, nil
+}
+
+func (t *taskRepo) CountTask(ctx context.Context, arg domain.ListTaskParam) (int32, error) {
+	var args queryArgs
+	conds := append([]string{taskVisibleTo(arg.UserID, &args)}, taskFilterConditions(arg.Filter, &args)...)
 
+	query := `SELECT count(*) FROM task` + whereClause(conds)
+	var count int32
+	if err := t.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, handleError(err, "task")
+	}
+	return count, nil
+}
+
+const taskColumns = `id, owner_id, project_id, parent_id, title, description, created_at, updated_at, limited_at, is_end, ` +
+	`status_id, (SELECT name FROM workflow_status WHERE workflow_status.id = task.status_id), status_changed_by, status_changed_at, priority, position, recurrence, recurrence_start, ` +
+	`EXISTS (SELECT 1 FROM task_dependency JOIN task blocker ON blocker.id = task_dependency.blocked_by_id WHERE task_dependency.task_id = task.id AND NOT blocker.is_end AND blocker.deleted_at IS NULL), ` +
+	`(SELECT count(*) FROM comment WHERE comment.task_id = task.id), deleted_at, deleted_by, version`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTask reads a row selected with taskColumns.
+func scanTask(row rowScanner) (domain.Task, error) {
+	var task domain.Task
+	var projectID, parentID, statusChangedBy sql.NullString
+	var recurrence, deletedBy sql.NullString
+	var statusChangedAt, recurrenceStart, deletedAt sql.NullTime
+	err := row.Scan(&task.ID, &task.OwnerID, &projectID, &parentID, &task.Title, &task.Description, &task.CreatedAt, &task.UpdateAt, &task.LimitedAt, &task.IsEnd,
+		&task.StatusID, &task.Status, &statusChangedBy, &statusChangedAt, &task.Priority, &task.Position, &recurrence, &recurrenceStart, &task.Blocked, &task.CommentCount,
+		&deletedAt, &deletedBy, &task.Version)
+	task.Recurrence = recurrence.String
+	if recurrenceStart.Valid {
+		task.RecurrenceStart = &recurrenceStart.Time
+	}
+	task.ProjectID = projectID.String
+	task.ParentID = parentID.String
+	task.StatusChangedBy = statusChangedBy.String
+	if statusChangedAt.Valid {
+		task.StatusChangedAt = &statusChangedAt.Time
+	}
+	if deletedAt.Valid {
+		task.DeletedAt = &deletedAt.Time
+	}
+	task.DeletedBy = deletedBy.String
+	return task, err
+}
+
+// taskVisibleTo matches the tasks userID can see: their own tasks outside any
+// project and every task of the projects they are a member of.
+func taskVisibleTo(userID string, args *queryArgs) string {
+	user := args.add(userID)
+	return fmt.Sprintf("((task.owner_id = %s AND task.project_id IS NULL) OR task.project_id IN (SELECT project_id FROM project_member WHERE user_id = %s))", user, user)
+}
+
+// taskOrderColumns maps the sortable columns to their SQL type.
+var taskOrderColumns = map[string]string{
+	domain.TaskOrderCreatedAt: "timestamptz",
+	domain.TaskOrderUpdatedAt: "timestamptz",
+	domain.TaskOrderLimitedAt: "timestamptz",
+	domain.TaskOrderTitle:     "varchar",
+	domain.TaskOrderPriority:  "smallint",
+	domain.TaskOrderPosition:  "varchar",
+	domain.TaskOrderDeletedAt: "timestamptz",
+}
+
+func taskFilterConditions(filter domain.TaskFilter, args *queryArgs) []string {
+	conds := []string{"task.deleted_at IS NULL"}
+	if filter.Deleted {
+		// 親と一緒に削除されたサブタスクは親を復元すると戻る
+		conds = []string{"task.deleted_at IS NOT NULL AND NOT EXISTS (SELECT 1 FROM task parent WHERE parent.id = task.parent_id AND parent.deleted_at IS NOT NULL)"}
+	}
+	if filter.IsEnd != nil {
+		conds = append(conds, "is_end = "+args.add(*filter.IsEnd))
+	}
+	if len(filter.TagIDs) > 0 {
+		if filter.MatchAllTags {
+			tagIDs := slices.Compact(slices.Sorted(slices.Values(filter.TagIDs)))
+			conds = append(conds, fmt.Sprintf("(SELECT count(DISTINCT tag_id) FROM task_tag WHERE task_tag.task_id = task.id AND tag_id = ANY(%s)) = %s", args.add(pq.Array(tagIDs)), args.add(len(tagIDs))))
+		} else {
+			conds = append(conds, fmt.Sprintf("EXISTS (SELECT 1 FROM task_tag WHERE task_tag.task_id = task.id AND tag_id = ANY(%s))", args.add(pq.Array(filter.TagIDs))))
+		}
+	}
+	if filter.LimitedBefore != nil {
+		conds = append(conds, "limited_at < "+args.add(*filter.LimitedBefore))
+	}
+	if filter.LimitedAfter != nil {
+		conds = append(conds, "limited_at >= "+args.add(*filter.LimitedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		conds = append(conds, "created_at < "+args.add(*filter.CreatedBefore))
+	}
+	if filter.CreatedAfter != nil {
+		conds = append(conds, "created_at >= "+args.add(*filter.CreatedAfter))
+	}
+	if filter.TitleContains != "" {
+		conds = append(conds, "title ILIKE '%' || "+args.add(escapeLike(filter.TitleContains))+" || '%'")
+	}
+	if filter.ProjectID != "" {
+		conds = append(conds, "project_id = "+args.add(filter.ProjectID))
+	}
+	if filter.ParentID != "" {
+		conds = append(conds, "parent_id = "+args.add(filter.ParentID))
+	}
+	if filter.Status != "" {
+		conds = append(conds, "status_id IN (SELECT id FROM workflow_status WHERE name = "+args.add(filter.Status)+")")
+	}
+	return conds
 }
 
 func (t *taskRepo) UpdateTask(ctx context.Context, tx *sql.Tx, arg domain.UpdateTaskParam) error {
-	const query = `UPDATE task SET title = $1, description = $2, limited_at = $3, is_end = $4 WHERE id = $5`
-	_, err := tx.ExecContext(ctx, query, arg.Title, arg.Description, arg.LimitedAt, arg.IsEnd, arg.ID)
-	return err
+	var sets []string
+	var args queryArgs
+	set := func(column string, value any) {
+		sets = append(sets, column+" = "+args.add(value))
+	}
+	for _, field := range arg.UpdateMask {
+		switch field {
+		case domain.TaskFieldTitle:
+			set("title", arg.Title)
+		case domain.TaskFieldDescription:
+			set("description", arg.Description)
+		case domain.TaskFieldLimitedAt:
+			set("limited_at", arg.LimitedAt)
+		case domain.TaskFieldParentID:
+			set("parent_id", nullString(arg.ParentID))
+		case domain.TaskFieldPriority:
+			set("priority", arg.Priority)
+		case domain.TaskFieldRecurrence:
+			set("recurrence", nullString(arg.Recurrence))
+			set("recurrence_start", arg.RecurrenceStart)
+		}
+	}
+	// 更新する列がなくても行の存在確認とupdated_atの更新は行う
+	if len(sets) == 0 {
+		sets = append(sets, "updated_at = CURRENT_TIMESTAMP")
+	}
+	query := fmt.Sprintf(`UPDATE task SET %s WHERE id = %s AND deleted_at IS NULL AND %s`, strings.Join(sets, ", "), args.add(arg.ID), taskVisibleTo(arg.UserID, &args))
+	if arg.Version != 0 {
+		query += ` AND version = ` + args.add(arg.Version)
+	}
+
+	row, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return handleError(err, "task")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	return checkTaskVersion(count, arg.Version)
+}
+
+// checkTaskVersion reports a write that matched no row. With a version the
+// task was seen just before, so the row was changed by another request.
+func checkTaskVersion(count, version int64) error {
+	if count > 0 {
+		return nil
+	}
+	if version != 0 {
+		return domain.NewConflictError("task", sql.ErrNoRows)
+	}
+	return domain.NewNotFoundError("task", sql.ErrNoRows)
 }
 
 func (t *taskRepo) DeleteTask(ctx context.Context, tx *sql.Tx, arg domain.DeleteTaskParam) error {
-	const query = `DELETE FROM task WHERE id = $1`
+	// サブタスクも同じdeleted_atでゴミ箱に入れ、親の復元でまとめて戻せるようにする
+	var args queryArgs
+	root := `id = ` + args.add(arg.ID) + ` AND deleted_at IS NULL AND ` + taskVisibleTo(arg.UserID, &args)
+	if arg.Version != 0 {
+		root += ` AND version = ` + args.add(arg.Version)
+	}
+	query := `WITH RECURSIVE deleted AS (
+		SELECT id FROM task WHERE ` + root + `
+		UNION
+		SELECT task.id FROM task JOIN deleted ON task.parent_id = deleted.id WHERE task.deleted_at IS NULL
+	)
+	UPDATE task SET deleted_at = CURRENT_TIMESTAMP, deleted_by = ` + args.add(arg.UserID) + ` FROM deleted WHERE task.id = deleted.id`
+	row, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return handleError(err, "task")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	return checkTaskVersion(count, arg.Version)
+}
+
+func (t *taskRepo) RestoreTask(ctx context.Context, tx *sql.Tx, arg domain.RestoreTaskParam) error {
+	const query = `WITH RECURSIVE restored AS (
+		SELECT id, deleted_at FROM task WHERE id = $1 AND deleted_at IS NOT NULL
+		UNION
+		SELECT task.id, task.deleted_at FROM task JOIN restored ON task.parent_id = restored.id WHERE task.deleted_at = restored.deleted_at
+	)
+	UPDATE task SET deleted_at = NULL, deleted_by = NULL FROM restored WHERE task.id = restored.id`
+
+	row, err := tx.ExecContext(ctx, query, arg.ID)
+	if err != nil {
+		return handleError(err, "task")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("task", sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (t *taskRepo) PurgeTask(ctx context.Context, arg domain.PurgeTaskParam) error {
+	// サブタスク、タグ、コメントなどはカスケードで削除される
+	const query = `DELETE FROM task WHERE id = $1 AND deleted_at IS NOT NULL`
+
 	row, err := t.db.ExecContext(ctx, query, arg.ID)
+	if err != nil {
+		return handleError(err, "task")
+	}
+	count, err := row.RowsAffected()
 	if err != nil {
 		return err
 	}
+	if count == 0 {
+		return domain.NewNotFoundError("task", sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (t *taskRepo) PurgeDeletedTask(ctx context.Context, arg domain.PurgeDeletedTaskParam) (int64, error) {
+	const query = `DELETE FROM task WHERE id IN (
+		SELECT id FROM task WHERE deleted_at < $1 ORDER BY deleted_at LIMIT $2
+	)`
+
+	row, err := t.db.ExecContext(ctx, query, arg.DeletedBefore, arg.Limit)
+	if err != nil {
+		return 0, handleError(err, "task")
+	}
+	return row.RowsAffected()
+}
+
+// IsTaskDescendant reports whether TaskID is AncestorID itself or one of its
+// subtasks at any depth.
+func (t *taskRepo) IsTaskDescendant(ctx context.Context, tx *sql.Tx, arg domain.IsTaskDescendantParam) (bool, error) {
+	const query = `WITH RECURSIVE ancestor AS (
+		SELECT id, parent_id FROM task WHERE id = $1
+		UNION
+		SELECT task.id, task.parent_id FROM task JOIN ancestor ON task.id = ancestor.parent_id
+	)
+	SELECT EXISTS (SELECT 1 FROM ancestor WHERE id = $2)`
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, query, arg.TaskID, arg.AncestorID).Scan(&exists); err != nil {
+		return false, handleError(err, "task")
+	}
+	return exists, nil
+}
+
+// subtaskTree selects the ids of every task below $1 that is not in the trash.
+const subtaskTree = `WITH RECURSIVE subtask AS (
+		SELECT id, is_end FROM task WHERE parent_id = $1 AND deleted_at IS NULL
+		UNION
+		SELECT task.id, task.is_end FROM task JOIN subtask ON task.parent_id = subtask.id WHERE task.deleted_at IS NULL
+	)`
+
+func (t *taskRepo) CountOpenSubtask(ctx context.Context, tx *sql.Tx, arg domain.SubtaskParam) (int32, error) {
+	const query = subtaskTree + ` SELECT count(*) FROM subtask WHERE NOT is_end`
+
+	var count int32
+	if err := tx.QueryRowContext(ctx, query, arg.TaskID).Scan(&count); err != nil {
+		return 0, handleError(err, "task")
+	}
+	return count, nil
+}
+
+func (t *taskRepo) CompleteSubtask(ctx context.Context, tx *sql.Tx, arg domain.CompleteSubtaskParam) (map[string]string, error) {
+	// RETURNINGでは更新後の値しか取れないため、更新前のステータスは結合して取る
+	const query = subtaskTree + ` UPDATE task SET status_id = $2, is_end = TRUE, status_changed_by = $3, status_changed_at = CURRENT_TIMESTAMP
+	FROM task previous JOIN workflow_status ON workflow_status.id = previous.status_id
+	WHERE task.id IN (SELECT id FROM subtask WHERE NOT is_end) AND previous.id = task.id
+	RETURNING task.id, workflow_status.name`
+
+	rows, err := tx.QueryContext(ctx, query, arg.TaskID, arg.StatusID, arg.UserID)
+	if err != nil {
+		return nil, handleError(err, "task")
+	}
+	defer rows.Close()
+
+	previous := map[string]string{}
+	for rows.Next() {
+		var id, status string
+		if err := rows.Scan(&id, &status); err != nil {
+			return nil, err
+		}
+		previous[id] = status
+	}
+	return previous, rows.Err()
+}
+
+func (t *taskRepo) TransitionTask(ctx context.Context, tx *sql.Tx, arg domain.TransitionTaskParam) error {
+	const query = `UPDATE task SET status_id = $1, is_end = $2, status_changed_by = $3, status_changed_at = CURRENT_TIMESTAMP
+	WHERE id = $4 AND status_id = $5`
+
+	row, err := tx.ExecContext(ctx, query, arg.StatusID, arg.IsEnd, arg.UserID, arg.ID, arg.FromStatusID)
+	if err != nil {
+		return handleError(err, "task")
+	}
 	count, err := row.RowsAffected()
 	if err != nil {
 		return err
 	}
+	// 読み取った後に他のリクエストがステータスを変えていた
 	if count == 0 {
-		return sql.ErrNoRows
+		return domain.NewConflictError("task", sql.ErrNoRows)
 	}
 	return nil
 }
+
+func (t *taskRepo) SyncTaskEnd(ctx context.Context, tx *sql.Tx, arg domain.SyncTaskEndParam) ([]domain.Task, error) {
+	const query = `UPDATE task SET is_end = NOT is_end
+	WHERE deleted_at IS NULL AND EXISTS (SELECT 1 FROM workflow_status WHERE workflow_status.id = task.status_id AND workflow_status.project_id = $1 AND workflow_status.is_end <> task.is_end)
+	RETURNING ` + taskColumns
+
+	rows, err := tx.QueryContext(ctx, query, arg.ProjectID)
+	if err != nil {
+		return nil, handleError(err, "task")
+	}
+	defer rows.Close()
+
+	var tasks []domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// taskInScope matches the tasks of the scope selected by $1 and $2 that are
+// not in the trash.
+const taskInScope = `project_id IS NOT DISTINCT FROM $1 AND (project_id IS NOT NULL OR owner_id = $2) AND deleted_at IS NULL`
+
+// GetLastTaskPosition returns the largest position in the scope, or an empty
+// string when it has no tasks.
+func (t *taskRepo) GetLastTaskPosition(ctx context.Context, tx *sql.Tx, arg domain.TaskScopeParam) (string, error) {
+	const query = `SELECT coalesce(max(position), '') FROM task WHERE ` + taskInScope
+
+	var position string
+	if err := tx.QueryRowContext(ctx, query, nullString(arg.ProjectID), arg.OwnerID).Scan(&position); err != nil {
+		return "", handleError(err, "task")
+	}
+	return position, nil
+}
+
+// GetNeighborPosition returns an empty string when there is no neighbor.
+func (t *taskRepo) GetNeighborPosition(ctx context.Context, tx *sql.Tx, arg domain.GetNeighborPositionParam) (string, error) {
+	query := `SELECT coalesce(min(position), '') FROM task WHERE ` + taskInScope + ` AND position > $3 AND id <> $4`
+	if arg.Before {
+		query = `SELECT coalesce(max(position), '') FROM task WHERE ` + taskInScope + ` AND position < $3 AND id <> $4`
+	}
+
+	var position string
+	if err := tx.QueryRowContext(ctx, query, nullString(arg.Scope.ProjectID), arg.Scope.OwnerID, arg.Position, arg.ExcludeID).Scan(&position); err != nil {
+		return "", handleError(err, "task")
+	}
+	return position, nil
+}
+
+func (t *taskRepo) MoveTask(ctx context.Context, tx *sql.Tx, arg domain.MoveTaskParam) error {
+	const query = `UPDATE task SET position = $1 WHERE id = $2`
+
+	row, err := tx.ExecContext(ctx, query, arg.Position, arg.ID)
+	if err != nil {
+		return handleError(err, "task")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("task", sql.ErrNoRows)
+	}
+	return nil
+}
+
+func (t *taskRepo) CreateNextOccurrence(ctx context.Context, tx *sql.Tx, arg domain.CreateNextOccurrenceParam) (bool, error) {
+	// 再び完了にしても次の回は二重に作らない
+	const query = `INSERT INTO task (id, owner_id, project_id, parent_id, title, description, limited_at, priority, position, recurrence, recurrence_start, previous_id, status_id, is_end)
+	SELECT $1, owner_id, project_id, parent_id, title, description, $2, priority, $3, recurrence, recurrence_start, id,
+		(SELECT id FROM workflow_status WHERE workflow_status.project_id IS NOT DISTINCT FROM task.project_id ORDER BY workflow_status.position LIMIT 1), FALSE
+	FROM task WHERE id = $4
+	ON CONFLICT (previous_id) DO NOTHING`
+
+	row, err := tx.ExecContext(ctx, query, arg.ID, arg.LimitedAt, arg.Position, arg.PreviousID)
+	if err != nil {
+		return false, handleError(err, "task")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ListTaskProgress counts the subtasks of each of TaskIDs in a single query.
+// Tasks without subtasks are left out of the result.
+func (t *taskRepo) ListTaskProgress(ctx context.Context, arg domain.ListTaskProgressParam) (map[string]domain.TaskProgress, error) {
+	progress := map[string]domain.TaskProgress{}
+	if len(arg.TaskIDs) == 0 {
+		return progress, nil
+	}
+	const query = `WITH RECURSIVE subtask AS (
+		SELECT parent_id AS root_id, id, is_end FROM task WHERE parent_id = ANY($1) AND deleted_at IS NULL
+		UNION
+		SELECT subtask.root_id, task.id, task.is_end FROM task JOIN subtask ON task.parent_id = subtask.id WHERE task.deleted_at IS NULL
+	)
+	SELECT root_id, count(*), count(*) FILTER (WHERE is_end) FROM subtask GROUP BY root_id`
+
+	rows, err := t.db.QueryContext(ctx, query, pq.Array(arg.TaskIDs))
+	if err != nil {
+		return nil, handleError(err, "task")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID string
+		var p domain.TaskProgress
+		if err := rows.Scan(&taskID, &p.Total, &p.Done); err != nil {
+			return nil, err
+		}
+		progress[taskID] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
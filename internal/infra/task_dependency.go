@@ -0,0 +1,85 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type taskDependencyRepo struct {
+	db *sql.DB
+}
+
+type TaskDependencyRepo interface {
+	CreateTaskDependency(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskDependencyParam) error
+	DeleteTaskDependency(ctx context.Context, tx *sql.Tx, arg domain.DeleteTaskDependencyParam) error
+	LockTaskDependency(ctx context.Context, tx *sql.Tx) error
+	IsTaskBlockedBy(ctx context.Context, tx *sql.Tx, arg domain.IsTaskBlockedByParam) (bool, error)
+	CountOpenBlocker(ctx context.Context, tx *sql.Tx, arg domain.CountOpenBlockerParam) (int32, error)
+}
+
+func NewTaskDependencyRepo(db *sql.DB) TaskDependencyRepo {
+	return &taskDependencyRepo{db: db}
+}
+
+func (t *taskDependencyRepo) CreateTaskDependency(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskDependencyParam) error {
+	const query = `INSERT INTO task_dependency (task_id, blocked_by_id) VALUES ($1,$2)`
+
+	_, err := tx.ExecContext(ctx, query, arg.TaskID, arg.BlockedByID)
+
+	return handleError(err, "task_dependency")
+}
+
+func (t *taskDependencyRepo) DeleteTaskDependency(ctx context.Context, tx *sql.Tx, arg domain.DeleteTaskDependencyParam) error {
+	const query = `DELETE FROM task_dependency WHERE task_id = $1 AND blocked_by_id = $2`
+
+	row, err := tx.ExecContext(ctx, query, arg.TaskID, arg.BlockedByID)
+	if err != nil {
+		return handleError(err, "task_dependency")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.NewNotFoundError("task_dependency", sql.ErrNoRows)
+	}
+	return nil
+}
+
+// LockTaskDependency serializes the transactions adding dependencies until
+// tx ends, so that the cycle check of one sees the dependencies of the other.
+func (t *taskDependencyRepo) LockTaskDependency(ctx context.Context, tx *sql.Tx) error {
+	// 二つのタスクの行ロックだけでは、離れた依存を同時に追加したときの循環を防げない
+	const query = `SELECT pg_advisory_xact_lock(hashtext('task_dependency'))`
+
+	_, err := tx.ExecContext(ctx, query)
+
+	return handleError(err, "task_dependency")
+}
+
+func (t *taskDependencyRepo) IsTaskBlockedBy(ctx context.Context, tx *sql.Tx, arg domain.IsTaskBlockedByParam) (bool, error) {
+	const query = `WITH RECURSIVE blocker AS (
+		SELECT blocked_by_id FROM task_dependency WHERE task_id = $1
+		UNION
+		SELECT task_dependency.blocked_by_id FROM task_dependency JOIN blocker ON task_dependency.task_id = blocker.blocked_by_id
+	)
+	SELECT EXISTS (SELECT 1 FROM blocker WHERE blocked_by_id = $2)`
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, query, arg.TaskID, arg.BlockedByID).Scan(&exists); err != nil {
+		return false, handleError(err, "task_dependency")
+	}
+	return exists, nil
+}
+
+func (t *taskDependencyRepo) CountOpenBlocker(ctx context.Context, tx *sql.Tx, arg domain.CountOpenBlockerParam) (int32, error) {
+	const query = `SELECT count(*) FROM task_dependency JOIN task ON task.id = task_dependency.blocked_by_id WHERE task_dependency.task_id = $1 AND NOT task.is_end AND task.deleted_at IS NULL`
+
+	var count int32
+	if err := tx.QueryRowContext(ctx, query, arg.TaskID).Scan(&count); err != nil {
+		return 0, handleError(err, "task_dependency")
+	}
+	return count, nil
+}
@@ -0,0 +1,78 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type taskHistoryRepo struct {
+	db *sql.DB
+}
+
+type TaskHistoryRepo interface {
+	// CreateTaskHistory records a change in the transaction that makes it, so
+	// that the history is kept only for changes that are committed.
+	CreateTaskHistory(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskHistoryParam) error
+	ListTaskHistory(ctx context.Context, arg domain.ListTaskHistoryParam) ([]domain.TaskHistory, error)
+	CountTaskHistory(ctx context.Context, arg domain.ListTaskHistoryParam) (int32, error)
+}
+
+func NewTaskHistoryRepo(db *sql.DB) TaskHistoryRepo {
+	return &taskHistoryRepo{db: db}
+}
+
+func (t *taskHistoryRepo) CreateTaskHistory(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskHistoryParam) error {
+	const query = `INSERT INTO task_event (task_id, actor_id, action, changes) VALUES ($1,$2,$3,$4)`
+
+	if arg.Changes == nil {
+		arg.Changes = []domain.FieldChange{}
+	}
+	changes, err := json.Marshal(arg.Changes)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, query, arg.TaskID, arg.ActorID, arg.Action, changes)
+
+	return handleError(err, "task_event")
+}
+
+func (t *taskHistoryRepo) ListTaskHistory(ctx context.Context, arg domain.ListTaskHistoryParam) ([]domain.TaskHistory, error) {
+	if arg.Limit == 0 {
+		arg.Limit = 100
+	}
+	const query = `SELECT id, task_id, actor_id, action, changes, created_at FROM task_event
+	WHERE task_id = $1 AND ($2::bigint = 0 OR id < $2) ORDER BY id DESC LIMIT $3`
+
+	rows, err := t.db.QueryContext(ctx, query, arg.TaskID, arg.BeforeID, arg.Limit)
+	if err != nil {
+		return nil, handleError(err, "task_event")
+	}
+	defer rows.Close()
+
+	var history []domain.TaskHistory
+	for rows.Next() {
+		var h domain.TaskHistory
+		var changes []byte
+		if err := rows.Scan(&h.ID, &h.TaskID, &h.ActorID, &h.Action, &changes, &h.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(changes, &h.Changes); err != nil {
+			return nil, err
+		}
+		history = append(history, h)
+	}
+	return history, rows.Err()
+}
+
+func (t *taskHistoryRepo) CountTaskHistory(ctx context.Context, arg domain.ListTaskHistoryParam) (int32, error) {
+	const query = `SELECT count(*) FROM task_event WHERE task_id = $1`
+
+	var count int32
+	if err := t.db.QueryRowContext(ctx, query, arg.TaskID).Scan(&count); err != nil {
+		return 0, handleError(err, "task_event")
+	}
+	return count, nil
+}
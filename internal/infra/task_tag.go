@@ -3,7 +3,9 @@ package infra
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
+	"github.com/lib/pq"
 	"github.com/sikigasa/task-controller/internal/domain"
 )
 
@@ -14,6 +16,8 @@ type taskTagRepo struct {
 type TaskTagRepo interface {
 	CreateTaskTag(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskTagParam) error
 	GetTaskTagIDs(ctx context.Context, arg domain.GetTaskTagParam) ([]domain.TaskTag, error)
+	ListTagsByTaskIDs(ctx context.Context, arg domain.ListTaskTagParam) (map[string][]domain.Tag, error)
+	CopyTaskTags(ctx context.Context, tx *sql.Tx, arg domain.CopyTaskTagParam) error
 	DeleteTaskTags(ctx context.Context, tx *sql.Tx, arg domain.DeleteTaskTagParam) error
 }
 
@@ -22,11 +26,25 @@ func NewTaskTagRepo(db *sql.DB) TaskTagRepo {
 }
 
 func (t *taskTagRepo) CreateTaskTag(ctx context.Context, tx *sql.Tx, arg domain.CreateTaskTagParam) error {
-	const query = `INSERT INTO task_tag (task_id, tag_id) VALUES ($1,$2)`
+	// 参照できないタグは存在しないものとして扱う
+	var args queryArgs
+	query := `INSERT INTO task_tag (task_id, tag_id) SELECT ` + args.add(arg.TaskID) + `, id FROM tag WHERE id = ` + args.add(arg.TagID) + ` AND ` + tagVisibleTo(arg.UserID, &args)
 
-	_, err := tx.ExecContext(ctx, query, arg.TaskID, arg.TagID)
-
-	return err
+	row, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return handleError(err, "task_tag")
+	}
+	count, err := row.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		e := domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", fmt.Sprintf("tag %s not found", arg.TagID))
+		e.Resource = "task_tag"
+		e.Field = "tag_ids"
+		return e
+	}
+	return nil
 }
 
 func (t *taskTagRepo) GetTaskTagIDs(ctx context.Context, arg domain.GetTaskTagParam) ([]domain.TaskTag, error) {
@@ -49,6 +67,45 @@ func (t *taskTagRepo) GetTaskTagIDs(ctx context.Context, arg domain.GetTaskTagPa
 	return taskTags, nil
 }
 
+// ListTagsByTaskIDs returns the tags of every given task keyed by task ID in
+// a single query.
+func (t *taskTagRepo) ListTagsByTaskIDs(ctx context.Context, arg domain.ListTaskTagParam) (map[string][]domain.Tag, error) {
+	const query = `SELECT task_tag.task_id, tag.id, tag.project_id, tag.name FROM task_tag JOIN tag ON tag.id = task_tag.tag_id WHERE task_tag.task_id = ANY($1) ORDER BY tag.id`
+
+	tags := make(map[string][]domain.Tag, len(arg.TaskIDs))
+	if len(arg.TaskIDs) == 0 {
+		return tags, nil
+	}
+	rows, err := t.db.QueryContext(ctx, query, pq.Array(arg.TaskIDs))
+	if err != nil {
+		return nil, handleError(err, "task_tag")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID string
+		var tag domain.Tag
+		var projectID sql.NullString
+		if err := rows.Scan(&taskID, &tag.ID, &projectID, &tag.Name); err != nil {
+			return nil, err
+		}
+		tag.ProjectID = projectID.String
+		tags[taskID] = append(tags[taskID], tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (t *taskTagRepo) CopyTaskTags(ctx context.Context, tx *sql.Tx, arg domain.CopyTaskTagParam) error {
+	const query = `INSERT INTO task_tag (task_id, tag_id) SELECT $1, tag_id FROM task_tag WHERE task_id = $2`
+
+	_, err := tx.ExecContext(ctx, query, arg.ToTaskID, arg.FromTaskID)
+
+	return handleError(err, "task_tag")
+}
+
 func (t *taskTagRepo) DeleteTaskTags(ctx context.Context, tx *sql.Tx, arg domain.DeleteTaskTagParam) error {
 	const query = `DELETE FROM task_tag WHERE task_id = $1`
 	_, err := tx.ExecContext(ctx, query, arg.TaskID)
@@ -0,0 +1,125 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+// taskEventChannel is the NOTIFY channel written by the task and task_tag triggers.
+const taskEventChannel = "task_events"
+
+// 購読者1人あたりに溜めておけるイベント数。これを超えた購読者は切断する
+const taskEventBuffer = 64
+
+type TaskWatcher interface {
+	// WatchTask streams task events until ctx is done. The channel is closed
+	// early when the subscriber falls behind or the database connection is
+	// lost, since events may have been missed.
+	WatchTask(ctx context.Context) <-chan domain.TaskEvent
+}
+
+type taskWatcher struct {
+	listener *pq.Listener
+
+	mu          sync.Mutex
+	subscribers map[chan domain.TaskEvent]struct{}
+	closed      bool
+}
+
+// NewTaskWatcher listens for task events on listener and fans them out to
+// every WatchTask caller. Closing listener closes all subscriptions.
+func NewTaskWatcher(listener *pq.Listener) (TaskWatcher, error) {
+	if err := listener.Listen(taskEventChannel); err != nil {
+		return nil, err
+	}
+	w := &taskWatcher{
+		listener:    listener,
+		subscribers: map[chan domain.TaskEvent]struct{}{},
+	}
+	go w.run()
+	return w, nil
+}
+
+func (w *taskWatcher) WatchTask(ctx context.Context) <-chan domain.TaskEvent {
+	ch := make(chan domain.TaskEvent, taskEventBuffer)
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		close(ch)
+		return ch
+	}
+	w.subscribers[ch] = struct{}{}
+	w.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		w.unsubscribe(ch)
+	}()
+	return ch
+}
+
+func (w *taskWatcher) run() {
+	for {
+		select {
+		case n, ok := <-w.listener.Notify:
+			if !ok {
+				w.mu.Lock()
+				w.closed = true
+				w.mu.Unlock()
+				w.closeAll()
+				return
+			}
+			// 再接続時はnilが届く。切断中のイベントは失われているので購読者に再取得させる
+			if n == nil {
+				w.closeAll()
+				continue
+			}
+			var event domain.TaskEvent
+			if err := json.Unmarshal([]byte(n.Extra), &event); err != nil {
+				log.Printf("invalid task event %q: %v", n.Extra, err)
+				continue
+			}
+			w.publish(event)
+		case <-time.After(90 * time.Second):
+			// 通知がない間も接続が生きているか確認する
+			go w.listener.Ping()
+		}
+	}
+}
+
+func (w *taskWatcher) publish(event domain.TaskEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch := range w.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(w.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (w *taskWatcher) unsubscribe(ch chan domain.TaskEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.subscribers[ch]; ok {
+		delete(w.subscribers, ch)
+		close(ch)
+	}
+}
+
+func (w *taskWatcher) closeAll() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch := range w.subscribers {
+		delete(w.subscribers, ch)
+		close(ch)
+	}
+}
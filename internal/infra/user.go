@@ -0,0 +1,72 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type userRepo struct {
+	db *sql.DB
+}
+
+type UserRepo interface {
+	CreateUser(ctx context.Context, arg domain.CreateUserParam) error
+	GetUserByEmail(ctx context.Context, arg domain.GetUserByEmailParam) (*domain.User, error)
+	ClaimOwnerlessData(ctx context.Context, arg domain.ClaimOwnerlessDataParam) (tasks, tags int64, err error)
+}
+
+func NewUserRepo(db *sql.DB) UserRepo {
+	return &userRepo{db: db}
+}
+
+func (u *userRepo) CreateUser(ctx context.Context, arg domain.CreateUserParam) error {
+	const query = `INSERT INTO users (id, email, password_hash) VALUES ($1,$2,$3)`
+
+	_, err := u.db.ExecContext(ctx, query, arg.ID, arg.Email, arg.PasswordHash)
+
+	return handleError(err, "user")
+}
+
+func (u *userRepo) GetUserByEmail(ctx context.Context, arg domain.GetUserByEmailParam) (*domain.User, error) {
+	const query = `SELECT id, email, password_hash, created_at FROM users WHERE lower(email) = lower($1)`
+
+	row := u.db.QueryRowContext(ctx, query, arg.Email)
+
+	var user domain.User
+	if err := row.Scan(&user.ID, &user.Email, &user.PasswordHash, &user.CreatedAt); err != nil {
+		return nil, handleError(err, "user")
+	}
+	return &user, nil
+}
+
+func (u *userRepo) ClaimOwnerlessData(ctx context.Context, arg domain.ClaimOwnerlessDataParam) (int64, int64, error) {
+	// タスクとタグをまとめて引き継ぐ。同じ名前のタグを既に持っている場合は、タスクをそのタグに付け替えて所有者なしのタグを消す
+	const query = `WITH dup AS (
+		SELECT tag.id, owned.id AS keep_id FROM tag
+		JOIN tag owned ON owned.owner_id = $1 AND lower(owned.name) = lower(tag.name)
+		WHERE tag.owner_id IS NULL AND tag.project_id IS NULL
+	), relinked AS (
+		INSERT INTO task_tag (task_id, tag_id)
+		SELECT DISTINCT task_tag.task_id, dup.keep_id FROM task_tag JOIN dup ON dup.id = task_tag.tag_id
+		WHERE NOT EXISTS (SELECT 1 FROM task_tag kept WHERE kept.task_id = task_tag.task_id AND kept.tag_id = dup.keep_id)
+	), merged_tag AS (
+		DELETE FROM tag USING dup WHERE tag.id = dup.id
+		RETURNING tag.id
+	), claimed_tag AS (
+		UPDATE tag SET owner_id = $1
+		WHERE owner_id IS NULL AND project_id IS NULL AND id NOT IN (SELECT id FROM dup)
+		RETURNING id
+	), claimed_task AS (
+		UPDATE task SET owner_id = $1 WHERE owner_id IS NULL AND project_id IS NULL
+		RETURNING id
+	)
+	SELECT (SELECT count(*) FROM claimed_task), (SELECT count(*) FROM claimed_tag) + (SELECT count(*) FROM merged_tag)`
+
+	var tasks, tags int64
+	if err := u.db.QueryRowContext(ctx, query, arg.UserID).Scan(&tasks, &tags); err != nil {
+		return 0, 0, handleError(err, "user")
+	}
+	return tasks, tags, nil
+}
@@ -0,0 +1,166 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type workflowRepo struct {
+	db *sql.DB
+}
+
+type WorkflowRepo interface {
+	CreateProjectWorkflow(ctx context.Context, tx *sql.Tx, arg domain.CreateProjectWorkflowParam) error
+	GetWorkflowStatus(ctx context.Context, arg domain.GetWorkflowStatusParam) (*domain.WorkflowStatus, error)
+	ListWorkflowStatus(ctx context.Context, arg domain.ListWorkflowParam) ([]domain.WorkflowStatus, error)
+	ListWorkflowTransition(ctx context.Context, arg domain.ListWorkflowParam) ([]domain.WorkflowTransition, error)
+	IsTransitionAllowed(ctx context.Context, tx *sql.Tx, arg domain.IsTransitionAllowedParam) (bool, error)
+	ReplaceWorkflow(ctx context.Context, tx *sql.Tx, arg domain.ReplaceWorkflowParam) error
+}
+
+func NewWorkflowRepo(db *sql.DB) WorkflowRepo {
+	return &workflowRepo{db: db}
+}
+
+// CreateProjectWorkflow copies the default workflow to a new project.
+func (w *workflowRepo) CreateProjectWorkflow(ctx context.Context, tx *sql.Tx, arg domain.CreateProjectWorkflowParam) error {
+	const query = `WITH status AS (
+		INSERT INTO workflow_status (id, project_id, name, is_end, position)
+		SELECT gen_random_uuid()::text, $1, name, is_end, position FROM workflow_status WHERE project_id IS NULL
+		RETURNING id, name
+	)
+	INSERT INTO workflow_transition (from_status_id, to_status_id)
+	SELECT f.id, t.id FROM workflow_transition
+	JOIN workflow_status df ON df.id = workflow_transition.from_status_id AND df.project_id IS NULL
+	JOIN workflow_status dt ON dt.id = workflow_transition.to_status_id
+	JOIN status f ON f.name = df.name
+	JOIN status t ON t.name = dt.name`
+
+	_, err := tx.ExecContext(ctx, query, arg.ProjectID)
+
+	return handleError(err, "workflow_status")
+}
+
+func (w *workflowRepo) GetWorkflowStatus(ctx context.Context, arg domain.GetWorkflowStatusParam) (*domain.WorkflowStatus, error) {
+	const query = `SELECT id, project_id, name, is_end, position FROM workflow_status WHERE project_id IS NOT DISTINCT FROM $1 AND name = $2`
+
+	status, err := scanWorkflowStatus(w.db.QueryRowContext(ctx, query, nullString(arg.ProjectID), arg.Name))
+	if err != nil {
+		return nil, handleError(err, "workflow_status")
+	}
+	return &status, nil
+}
+
+func (w *workflowRepo) ListWorkflowStatus(ctx context.Context, arg domain.ListWorkflowParam) ([]domain.WorkflowStatus, error) {
+	const query = `SELECT id, project_id, name, is_end, position FROM workflow_status WHERE project_id IS NOT DISTINCT FROM $1 ORDER BY position`
+
+	rows, err := w.db.QueryContext(ctx, query, nullString(arg.ProjectID))
+	if err != nil {
+		return nil, handleError(err, "workflow_status")
+	}
+	defer rows.Close()
+	var statuses []domain.WorkflowStatus
+	for rows.Next() {
+		status, err := scanWorkflowStatus(rows)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+func (w *workflowRepo) ListWorkflowTransition(ctx context.Context, arg domain.ListWorkflowParam) ([]domain.WorkflowTransition, error) {
+	const query = `SELECT f.name, t.name FROM workflow_transition
+	JOIN workflow_status f ON f.id = workflow_transition.from_status_id
+	JOIN workflow_status t ON t.id = workflow_transition.to_status_id
+	WHERE f.project_id IS NOT DISTINCT FROM $1
+	ORDER BY f.position, t.position`
+
+	rows, err := w.db.QueryContext(ctx, query, nullString(arg.ProjectID))
+	if err != nil {
+		return nil, handleError(err, "workflow_transition")
+	}
+	defer rows.Close()
+	var transitions []domain.WorkflowTransition
+	for rows.Next() {
+		var transition domain.WorkflowTransition
+		if err := rows.Scan(&transition.From, &transition.To); err != nil {
+			return nil, err
+		}
+		transitions = append(transitions, transition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+func (w *workflowRepo) IsTransitionAllowed(ctx context.Context, tx *sql.Tx, arg domain.IsTransitionAllowedParam) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM workflow_transition WHERE from_status_id = $1 AND to_status_id = $2)`
+
+	var allowed bool
+	if err := tx.QueryRowContext(ctx, query, arg.FromStatusID, arg.ToStatusID).Scan(&allowed); err != nil {
+		return false, handleError(err, "workflow_transition")
+	}
+	return allowed, nil
+}
+
+func (w *workflowRepo) ReplaceWorkflow(ctx context.Context, tx *sql.Tx, arg domain.ReplaceWorkflowParam) error {
+	names := make([]string, 0, len(arg.Statuses))
+	for _, status := range arg.Statuses {
+		names = append(names, status.Name)
+	}
+
+	// タスクが残っているステータスは外部キーにより削除できない
+	const deleteStatus = `DELETE FROM workflow_status WHERE project_id = $1 AND NOT (name = ANY($2))`
+	if _, err := tx.ExecContext(ctx, deleteStatus, arg.ProjectID, pq.Array(names)); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == pqForeignKeyViolation {
+			e := domain.NewFailedPreconditionError("STATUS_IN_USE", "statuses that tasks are in cannot be removed")
+			e.Resource = "workflow_status"
+			e.Field = "statuses"
+			e.Err = err
+			return e
+		}
+		return handleError(err, "workflow_status")
+	}
+
+	// 名前が同じステータスはIDを引き継ぐ
+	const upsertStatus = `INSERT INTO workflow_status (id, project_id, name, is_end, position) VALUES ($1,$2,$3,$4,$5)
+	ON CONFLICT (project_id, name) WHERE project_id IS NOT NULL DO UPDATE SET is_end = EXCLUDED.is_end, position = EXCLUDED.position`
+	for _, status := range arg.Statuses {
+		if _, err := tx.ExecContext(ctx, upsertStatus, status.ID, arg.ProjectID, status.Name, status.IsEnd, status.Position); err != nil {
+			return handleError(err, "workflow_status")
+		}
+	}
+
+	const deleteTransition = `DELETE FROM workflow_transition WHERE from_status_id IN (SELECT id FROM workflow_status WHERE project_id = $1)`
+	if _, err := tx.ExecContext(ctx, deleteTransition, arg.ProjectID); err != nil {
+		return handleError(err, "workflow_transition")
+	}
+	const createTransition = `INSERT INTO workflow_transition (from_status_id, to_status_id)
+	SELECT f.id, t.id FROM workflow_status f JOIN workflow_status t ON t.project_id = f.project_id
+	WHERE f.project_id = $1 AND f.name = $2 AND t.name = $3`
+	for _, transition := range arg.Transitions {
+		if _, err := tx.ExecContext(ctx, createTransition, arg.ProjectID, transition.From, transition.To); err != nil {
+			return handleError(err, "workflow_transition")
+		}
+	}
+	return nil
+}
+
+func scanWorkflowStatus(row rowScanner) (domain.WorkflowStatus, error) {
+	var status domain.WorkflowStatus
+	var projectID sql.NullString
+	err := row.Scan(&status.ID, &projectID, &status.Name, &status.IsEnd, &status.Position)
+	status.ProjectID = projectID.String
+	return status, err
+}
@@ -0,0 +1,86 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+type authInterceptor struct {
+	authenticator *auth.Authenticator
+	public        []string
+}
+
+// NewAuthInterceptor rejects requests without a valid bearer access token or
+// API key and stores the authenticated user in the request context. public
+// lists the services or procedures that can be called without credentials.
+func NewAuthInterceptor(authenticator *auth.Authenticator, public ...string) connect.Interceptor {
+	return &authInterceptor{
+		authenticator: authenticator,
+		public:        public,
+	}
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if i.isPublic(req.Spec().Procedure) {
+			return next(ctx, req)
+		}
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if i.isPublic(conn.Spec().Procedure) {
+			return next(ctx, conn)
+		}
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *authInterceptor) isPublic(procedure string) bool {
+	for _, p := range i.public {
+		// サービス名の場合は配下のすべてのプロシージャを公開する
+		if procedure == p || strings.HasPrefix(procedure, "/"+p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *authInterceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	authorization := header.Get("Authorization")
+	if authorization == "" {
+		return ctx, domain.NewUnauthenticatedError("authentication required")
+	}
+	credential, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return ctx, domain.NewUnauthenticatedError("authorization header must be a bearer token")
+	}
+	userID, err := i.authenticator.Authenticate(ctx, credential)
+	if errors.Is(err, auth.ErrInvalidToken) {
+		return ctx, domain.NewUnauthenticatedError("invalid or expired credentials")
+	}
+	if err != nil {
+		return ctx, err
+	}
+	return auth.WithUserID(ctx, userID), nil
+}
@@ -0,0 +1,130 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+)
+
+type fakeAPIKeyStore map[string]domain.APIKey
+
+func (s fakeAPIKeyStore) GetAPIKeyByHash(ctx context.Context, arg domain.GetAPIKeyByHashParam) (*domain.APIKey, error) {
+	apiKey, ok := s[arg.KeyHash]
+	if !ok {
+		return nil, domain.NewNotFoundError("api_key", nil)
+	}
+	return &apiKey, nil
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	tokens := auth.NewHS256TokenManager([]byte("test-secret"), time.Hour)
+	apiKey, err := auth.GenerateAPIKey()
+	if err != nil {
+		t.Fatalf("failed to generate api key: %v", err)
+	}
+	apiKeys := fakeAPIKeyStore{auth.HashAPIKey(apiKey): {ID: "key1", UserID: "user2"}}
+	i := NewAuthInterceptor(auth.NewAuthenticator(tokens, apiKeys), v1connect.AuthServiceLoginProcedure, "grpc.health.v1.Health").(*authInterceptor)
+
+	authenticate := func(authorization string) (string, error) {
+		header := http.Header{}
+		if authorization != "" {
+			header.Set("Authorization", authorization)
+		}
+		ctx, err := i.authenticate(context.Background(), header)
+		userID, _ := auth.UserID(ctx)
+		return userID, err
+	}
+
+	t.Run("正常系_アクセストークン", func(t *testing.T) {
+		token, _, err := tokens.Issue("user1")
+		if err != nil {
+			t.Fatalf("failed to issue token: %v", err)
+		}
+		userID, err := authenticate("Bearer " + token)
+		if err != nil || userID != "user1" {
+			t.Errorf("expected user1, got %q (%v)", userID, err)
+		}
+	})
+
+	t.Run("正常系_APIキー", func(t *testing.T) {
+		userID, err := authenticate("Bearer " + apiKey)
+		if err != nil || userID != "user2" {
+			t.Errorf("expected user2, got %q (%v)", userID, err)
+		}
+	})
+
+	t.Run("正常系_認証不要なプロシージャ", func(t *testing.T) {
+		for _, procedure := range []string{v1connect.AuthServiceLoginProcedure, "/grpc.health.v1.Health/Check"} {
+			if !i.isPublic(procedure) {
+				t.Errorf("expected %s to be public", procedure)
+			}
+		}
+		if i.isPublic(v1connect.TaskServiceGetTaskProcedure) {
+			t.Errorf("expected %s to require credentials", v1connect.TaskServiceGetTaskProcedure)
+		}
+	})
+
+	t.Run("異常系_認証情報なし", func(t *testing.T) {
+		called := false
+		next := i.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			called = true
+			return connect.NewResponse(&task.GetTaskResponse{}), nil
+		})
+		_, err := next(context.Background(), connect.NewRequest(&task.GetTaskRequest{}))
+		if !errors.Is(err, domain.ErrUnauthenticated) || called {
+			t.Errorf("expected unauthenticated error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_不正な認証情報", func(t *testing.T) {
+		other := auth.NewHS256TokenManager([]byte("other-secret"), time.Hour)
+		token, _, err := other.Issue("user1")
+		if err != nil {
+			t.Fatalf("failed to issue token: %v", err)
+		}
+		expired := auth.NewHS256TokenManager([]byte("test-secret"), -time.Minute)
+		expiredToken, _, err := expired.Issue("user1")
+		if err != nil {
+			t.Fatalf("failed to issue token: %v", err)
+		}
+		for _, authorization := range []string{"Bearer " + token, "Bearer " + expiredToken, "Bearer tc_unknown", "Basic dXNlcjpwYXNz"} {
+			if _, err := authenticate(authorization); !errors.Is(err, domain.ErrUnauthenticated) {
+				t.Errorf("expected unauthenticated error for %q, got %v", authorization, err)
+			}
+		}
+	})
+
+	t.Run("正常系_RS256", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		rs256 := auth.NewRS256TokenManager(key, &key.PublicKey, time.Hour)
+		token, _, err := rs256.Issue("user3")
+		if err != nil {
+			t.Fatalf("failed to issue token: %v", err)
+		}
+		// 公開鍵だけでも検証できる
+		verifier := auth.NewRS256TokenManager(nil, &key.PublicKey, time.Hour)
+		if userID, err := verifier.Verify(token); err != nil || userID != "user3" {
+			t.Errorf("expected user3, got %q (%v)", userID, err)
+		}
+		if _, _, err := verifier.Issue("user3"); !errors.Is(err, auth.ErrNoSigningKey) {
+			t.Errorf("expected no signing key error, got %v", err)
+		}
+		// HS256のサーバーはRS256のトークンを受け付けない
+		if _, err := authenticate("Bearer " + token); !errors.Is(err, domain.ErrUnauthenticated) {
+			t.Errorf("expected unauthenticated error, got %v", err)
+		}
+	})
+}
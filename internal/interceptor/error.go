@@ -0,0 +1,123 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+const errorDomain = "task-controller.sikigasa.github.com"
+
+type errorInterceptor struct{}
+
+// NewErrorInterceptor translates errors returned by the usecase layer into
+// Connect errors with the matching status code.
+func NewErrorInterceptor() connect.Interceptor {
+	return &errorInterceptor{}
+}
+
+func (i *errorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			return nil, ToConnectError(req.Spec().Procedure, err)
+		}
+		return res, nil
+	}
+}
+
+func (i *errorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *errorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := next(ctx, conn); err != nil {
+			return ToConnectError(conn.Spec().Procedure, err)
+		}
+		return nil
+	}
+}
+
+// ToConnectError converts err into a *connect.Error. Domain errors keep their
+// message and carry ErrorInfo (and BadRequest for invalid fields) details;
+// anything else is logged and reported as Internal without leaking the cause.
+func ToConnectError(procedure string, err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		log.Printf("%s: %v", procedure, err)
+		return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+	}
+
+	connectErr = connect.NewError(codeOf(domainErr), errors.New(domainErr.Message))
+	info := &errdetails.ErrorInfo{
+		Reason:   domainErr.Reason,
+		Domain:   errorDomain,
+		Metadata: map[string]string{},
+	}
+	if domainErr.Resource != "" {
+		info.Metadata["resource"] = domainErr.Resource
+	}
+	if domainErr.Field != "" {
+		info.Metadata["field"] = domainErr.Field
+	}
+	if detail, err := connect.NewErrorDetail(info); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	if errors.Is(domainErr, domain.ErrInvalidArgument) {
+		violations := domainErr.Violations
+		if len(violations) == 0 && domainErr.Field != "" {
+			violations = []domain.FieldViolation{{Field: domainErr.Field, Description: domainErr.Message}}
+		}
+		if len(violations) > 0 {
+			badRequest := &errdetails.BadRequest{}
+			for _, v := range violations {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       v.Field,
+					Description: v.Description,
+				})
+			}
+			if detail, err := connect.NewErrorDetail(badRequest); err == nil {
+				connectErr.AddDetail(detail)
+			}
+		}
+	}
+	return connectErr
+}
+
+func codeOf(err *domain.Error) connect.Code {
+	switch err.Kind {
+	case domain.ErrNotFound:
+		return connect.CodeNotFound
+	case domain.ErrAlreadyExists:
+		return connect.CodeAlreadyExists
+	case domain.ErrInvalidArgument:
+		return connect.CodeInvalidArgument
+	case domain.ErrFailedPrecondition:
+		return connect.CodeFailedPrecondition
+	case domain.ErrConflict:
+		return connect.CodeAborted
+	case domain.ErrUnavailable:
+		return connect.CodeUnavailable
+	case domain.ErrUnauthenticated:
+		return connect.CodeUnauthenticated
+	case domain.ErrPermissionDenied:
+		return connect.CodePermissionDenied
+	}
+	return connect.CodeUnknown
+}
@@ -0,0 +1,70 @@
+package interceptor
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestToConnectError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want connect.Code
+	}{
+		{"NotFound", domain.NewNotFoundError("task", sql.ErrNoRows), connect.CodeNotFound},
+		{"AlreadyExists", domain.NewAlreadyExistsError("tag", "tag_name_key", nil), connect.CodeAlreadyExists},
+		{"InvalidArgument", domain.NewInvalidArgumentError("title", "title is required"), connect.CodeInvalidArgument},
+		{"FailedPrecondition", domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", "tag is not present"), connect.CodeFailedPrecondition},
+		{"Conflict", domain.NewConflictError("task", nil), connect.CodeAborted},
+		{"Unavailable", domain.NewUnavailableError("WATCH_INTERRUPTED", "watch interrupted"), connect.CodeUnavailable},
+		{"Unauthenticated", domain.NewUnauthenticatedError("login required"), connect.CodeUnauthenticated},
+		{"PermissionDenied", domain.NewPermissionDeniedError("project", "editor role is required"), connect.CodePermissionDenied},
+		{"ConnectError", connect.NewError(connect.CodeUnauthenticated, errors.New("no token")), connect.CodeUnauthenticated},
+		{"Unknown", errors.New("connection refused"), connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ToConnectError("/test", tt.err)
+			if got := connect.CodeOf(err); got != tt.want {
+				t.Errorf("expected code %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestToConnectErrorDetails(t *testing.T) {
+	err := ToConnectError("/test", domain.NewInvalidArgumentError("title", "title is required"))
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		t.Fatalf("expected *connect.Error, got %T", err)
+	}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			t.Fatalf("failed to decode detail: %v", err)
+		}
+		switch v := value.(type) {
+		case *errdetails.ErrorInfo:
+			info = v
+		case *errdetails.BadRequest:
+			badRequest = v
+		}
+	}
+
+	if info == nil || info.Reason != "INVALID_ARGUMENT" {
+		t.Errorf("expected ErrorInfo with reason INVALID_ARGUMENT, got %v", info)
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "title" {
+		t.Errorf("expected BadRequest violation for title, got %v", badRequest)
+	}
+}
@@ -0,0 +1,121 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidateInterceptor(t *testing.T) {
+	i, err := NewValidateInterceptor()
+	if err != nil {
+		t.Fatalf("failed to create interceptor: %v", err)
+	}
+	called := false
+	next := i.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		called = true
+		return connect.NewResponse(&task.CreateTaskResponse{}), nil
+	})
+
+	t.Run("正常系", func(t *testing.T) {
+		called = false
+		req := connect.NewRequest(&task.CreateTaskRequest{
+			Title:     "タスク",
+			LimitedAt: timestamppb.New(time.Now()),
+			TagIds:    []string{uuid.NewString()},
+		})
+		if _, err := next(context.Background(), req); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if !called {
+			t.Errorf("expected handler to be called")
+		}
+	})
+
+	t.Run("異常系_不正なリクエスト", func(t *testing.T) {
+		called = false
+		req := connect.NewRequest(&task.CreateTaskRequest{
+			Title:  "",
+			TagIds: []string{"not-a-uuid"},
+		})
+		_, err := next(context.Background(), req)
+		if called {
+			t.Errorf("expected handler not to be called")
+		}
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Fatalf("expected invalid argument error, got %v", err)
+		}
+		fields := map[string]bool{}
+		for _, v := range domainErr.Violations {
+			fields[v.Field] = true
+		}
+		for _, field := range []string{"title", "limited_at", "tag_ids[0]"} {
+			if !fields[field] {
+				t.Errorf("expected violation for %s, got %v", field, domainErr.Violations)
+			}
+		}
+	})
+
+	t.Run("正常系_update_maskによる部分更新", func(t *testing.T) {
+		req := connect.NewRequest(&task.UpdateTaskRequest{
+			Id:          uuid.NewString(),
+			Description: "説明のみ",
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		})
+		if _, err := next(context.Background(), req); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_update_maskに更新できないフィールド", func(t *testing.T) {
+		req := connect.NewRequest(&task.UpdateTaskRequest{
+			Id:         uuid.NewString(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+		})
+		if _, err := next(context.Background(), req); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_update_maskなしでタイトルが空", func(t *testing.T) {
+		req := connect.NewRequest(&task.UpdateTaskRequest{
+			Id:        uuid.NewString(),
+			LimitedAt: timestamppb.New(time.Now()),
+		})
+		if _, err := next(context.Background(), req); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_空のupdate_maskでタイトルと期限が空", func(t *testing.T) {
+		req := connect.NewRequest(&task.UpdateTaskRequest{
+			Id:         uuid.NewString(),
+			UpdateMask: &fieldmaskpb.FieldMask{},
+		})
+		_, err := next(context.Background(), req)
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) {
+			t.Fatalf("expected domain error, got %v", err)
+		}
+		// メッセージ単位のルールなのでFieldは空になる
+		descriptions := map[string]bool{}
+		for _, v := range domainErr.Violations {
+			descriptions[v.Description] = true
+		}
+		for _, description := range []string{"title must not be empty", "limited_at is required"} {
+			if !descriptions[description] {
+				t.Errorf("expected violation %q, got %v", description, domainErr.Violations)
+			}
+		}
+	})
+}
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgres "github.com/sikigasa/task-controller/internal/infra/driver"
+	attachment "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type attachmentService struct {
+	v1connect.UnimplementedAttachmentServiceHandler
+	attachmentRepo infra.AttachmentRepo
+	taskRepo       infra.TaskRepo
+	projectRepo    infra.ProjectRepo
+	storage        infra.ObjectStorage
+}
+
+// NewAttachmentService creates the attachment service. storage may be nil when
+// no object storage is configured.
+func NewAttachmentService(attachmentRepo infra.AttachmentRepo, taskRepo infra.TaskRepo, projectRepo infra.ProjectRepo, storage infra.ObjectStorage) v1connect.AttachmentServiceHandler {
+	return &attachmentService{
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		projectRepo:    projectRepo,
+		storage:        storage,
+	}
+}
+
+func (a *attachmentService) CreateAttachment(ctx context.Context, req *attachment.CreateAttachmentRequest) (*attachment.CreateAttachmentResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.checkStorage(); err != nil {
+		return nil, err
+	}
+	if _, err := authorizeTaskWrite(ctx, a.taskRepo, a.projectRepo, req.TaskId, userID); err != nil {
+		return nil, err
+	}
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	// ファイル名はキーに含めず、ダウンロード時のContent-Dispositionでのみ使う
+	param := domain.CreateAttachmentParam{
+		ID:          uuid.String(),
+		TaskID:      req.TaskId,
+		UploadedBy:  userID,
+		Filename:    req.Filename,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		ObjectKey:   "tasks/" + req.TaskId + "/" + uuid.String(),
+	}
+
+	upload, err := a.storage.PresignUpload(ctx, domain.PresignUploadParam{
+		ObjectKey:   param.ObjectKey,
+		ContentType: param.ContentType,
+		Size:        param.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := a.attachmentRepo.CreateAttachment(ctx, param); err != nil {
+		return nil, err
+	}
+	result, err := a.attachmentRepo.GetAttachment(ctx, domain.GetAttachmentParam{ID: param.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachment.CreateAttachmentResponse{
+		Attachment: toProtoAttachment(*result),
+		Upload:     toProtoPresignedURL(upload),
+	}, nil
+}
+
+func (a *attachmentService) ListAttachments(ctx context.Context, req *attachment.ListAttachmentsRequest) (*attachment.ListAttachmentsResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: req.TaskId, UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	attachments, err := a.attachmentRepo.ListAttachment(ctx, domain.ListAttachmentParam{TaskID: req.TaskId})
+	if err != nil {
+		return nil, err
+	}
+
+	var attachmentList []*attachment.Attachment
+	for _, at := range attachments {
+		attachmentList = append(attachmentList, toProtoAttachment(at))
+	}
+
+	return &attachment.ListAttachmentsResponse{
+		Attachments: attachmentList,
+	}, nil
+}
+
+func (a *attachmentService) GetAttachment(ctx context.Context, req *attachment.GetAttachmentRequest) (*attachment.GetAttachmentResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.checkStorage(); err != nil {
+		return nil, err
+	}
+	result, err := a.attachmentRepo.GetAttachment(ctx, domain.GetAttachmentParam{ID: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: result.TaskID, UserID: userID}); err != nil {
+		return nil, attachmentNotFound(err)
+	}
+
+	download, err := a.storage.PresignDownload(ctx, domain.PresignDownloadParam{
+		ObjectKey: result.ObjectKey,
+		Filename:  result.Filename,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachment.GetAttachmentResponse{
+		Attachment: toProtoAttachment(*result),
+		Download:   toProtoPresignedURL(download),
+	}, nil
+}
+
+func (a *attachmentService) DeleteAttachment(ctx context.Context, req *attachment.DeleteAttachmentRequest) (*attachment.DeleteAttachmentResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := a.attachmentRepo.GetAttachment(ctx, domain.GetAttachmentParam{ID: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeTaskWrite(ctx, a.taskRepo, a.projectRepo, result.TaskID, userID); err != nil {
+		return nil, attachmentNotFound(err)
+	}
+
+	// オブジェクトはAttachmentCleanerが削除する
+	if err := a.attachmentRepo.DeleteAttachment(ctx, domain.DeleteAttachmentParam{ID: req.Id}); err != nil {
+		return nil, err
+	}
+
+	return &attachment.DeleteAttachmentResponse{
+		Success: true,
+	}, nil
+}
+
+func (a *attachmentService) checkStorage() error {
+	if a.storage == nil {
+		return domain.NewUnavailableError("STORAGE_NOT_CONFIGURED", "no object storage is configured for attachments")
+	}
+	return nil
+}
+
+// attachmentNotFound reports a task that cannot be seen as a missing
+// attachment, so that attachments do not reveal the task.
+func attachmentNotFound(err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.NewNotFoundError("attachment", err)
+	}
+	return err
+}
+
+func toProtoAttachment(a domain.Attachment) *attachment.Attachment {
+	return &attachment.Attachment{
+		Id:          a.ID,
+		TaskId:      a.TaskID,
+		UploadedBy:  a.UploadedBy,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
+
+func toProtoPresignedURL(u *domain.PresignedURL) *attachment.PresignedUrl {
+	return &attachment.PresignedUrl{
+		Url:       u.URL,
+		Headers:   u.Header,
+		ExpiresAt: timestamppb.New(u.ExpiresAt),
+	}
+}
+
+// 1回の実行で削除するオブジェクトの上限。S3のDeleteObjectsは1000件まで
+const attachmentCleanupBatchSize = 100
+
+// AttachmentCleaner deletes the objects of deleted attachments from object
+// storage, including those deleted along with their task.
+type AttachmentCleaner struct {
+	attachmentRepo infra.AttachmentRepo
+	storage        infra.ObjectStorage
+	tx             postgres.Transaction
+}
+
+func NewAttachmentCleaner(attachmentRepo infra.AttachmentRepo, storage infra.ObjectStorage, tx postgres.Transaction) *AttachmentCleaner {
+	return &AttachmentCleaner{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		tx:             tx,
+	}
+}
+
+// Run deletes orphaned objects every interval until ctx is done.
+func (c *AttachmentCleaner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := c.DeleteOrphanedObjects(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("attachment cleanup: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteOrphanedObjects deletes a batch of orphaned objects and returns how
+// many were deleted. Objects that fail to be deleted are tried again later.
+func (c *AttachmentCleaner) DeleteOrphanedObjects(ctx context.Context) (int, error) {
+	var deleted int
+	err := c.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		keys, err := c.attachmentRepo.ListAttachmentOrphan(ctx, tx, domain.ListAttachmentOrphanParam{Limit: attachmentCleanupBatchSize})
+		if err != nil || len(keys) == 0 {
+			return err
+		}
+		if err := c.storage.DeleteObjects(ctx, keys); err != nil {
+			return err
+		}
+		deleted = len(keys)
+		return c.attachmentRepo.DeleteAttachmentOrphan(ctx, tx, domain.DeleteAttachmentOrphanParam{ObjectKeys: keys})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgresDriver "github.com/sikigasa/task-controller/internal/infra/driver"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeStorage presigns fake URLs and records the deleted objects, or fails
+// deletions while err is set.
+type fakeStorage struct {
+	mu      sync.Mutex
+	deleted []string
+	err     error
+}
+
+func (s *fakeStorage) PresignUpload(ctx context.Context, arg domain.PresignUploadParam) (*domain.PresignedURL, error) {
+	return &domain.PresignedURL{
+		URL:       "https://storage.example.com/" + arg.ObjectKey + "?upload",
+		Header:    map[string]string{"Content-Type": arg.ContentType},
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil
+}
+
+func (s *fakeStorage) PresignDownload(ctx context.Context, arg domain.PresignDownloadParam) (*domain.PresignedURL, error) {
+	return &domain.PresignedURL{
+		URL:       "https://storage.example.com/" + arg.ObjectKey + "?download",
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil
+}
+
+func (s *fakeStorage) DeleteObjects(ctx context.Context, keys []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.deleted = append(s.deleted, keys...)
+	return nil
+}
+
+func TestAttachment(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	projectService := NewProjectService(infra.NewProjectRepo(db), infra.NewProjectInvitationRepo(db), infra.NewUserRepo(db), infra.NewWorkflowRepo(db), infra.NewTaskRepo(db), infra.NewTaskTagRepo(db), infra.NewTaskHistoryRepo(db), postgresDriver.NewPostgresTransaction(db))
+	taskService := setupTestService(t, db, connStr)
+	storage := &fakeStorage{}
+	attachmentRepo := infra.NewAttachmentRepo(db)
+	attachmentService := NewAttachmentService(attachmentRepo, infra.NewTaskRepo(db), infra.NewProjectRepo(db), storage)
+	cleaner := NewAttachmentCleaner(attachmentRepo, storage, postgresDriver.NewPostgresTransaction(db))
+
+	createTestUser(t, db, "viewer_user")
+	createTestUser(t, db, "other_user")
+	viewerCtx := auth.WithUserID(context.Background(), "viewer_user")
+	otherCtx := auth.WithUserID(context.Background(), "other_user")
+
+	projectRes, err := projectService.CreateProject(testUserContext(), &task.CreateProjectRequest{Name: "Attachments"})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	inviteTestMember(t, projectService, projectRes.Id, "viewer_user", task.ProjectRole_PROJECT_ROLE_VIEWER)
+	taskRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:     "添付ファイルのあるタスク",
+		LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		ProjectId: projectRes.Id,
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	taskID := taskRes.Id
+
+	createAttachment := func(t *testing.T, filename string) *task.CreateAttachmentResponse {
+		res, err := attachmentService.CreateAttachment(testUserContext(), &task.CreateAttachmentRequest{
+			TaskId:      taskID,
+			Filename:    filename,
+			ContentType: "application/pdf",
+			Size:        1024,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return res
+	}
+
+	var attachmentID string
+	t.Run("正常系_アップロード用のURLを発行", func(t *testing.T) {
+		res := createAttachment(t, "議事録.pdf")
+		attachmentID = res.Attachment.Id
+		if res.Attachment.TaskId != taskID || res.Attachment.Filename != "議事録.pdf" || res.Attachment.Size != 1024 || res.Attachment.UploadedBy != testUserID {
+			t.Errorf("unexpected attachment: %v", res.Attachment)
+		}
+		if res.Upload.GetUrl() == "" || res.Upload.Headers["Content-Type"] != "application/pdf" {
+			t.Errorf("unexpected upload URL: %v", res.Upload)
+		}
+	})
+
+	t.Run("正常系_閲覧者は一覧とダウンロードができる", func(t *testing.T) {
+		listRes, err := attachmentService.ListAttachments(viewerCtx, &task.ListAttachmentsRequest{TaskId: taskID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(listRes.Attachments) != 1 || listRes.Attachments[0].Id != attachmentID {
+			t.Errorf("unexpected attachments: %v", listRes.Attachments)
+		}
+
+		getRes, err := attachmentService.GetAttachment(viewerCtx, &task.GetAttachmentRequest{Id: attachmentID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if getRes.Download.GetUrl() == "" {
+			t.Errorf("expected download URL")
+		}
+	})
+
+	t.Run("異常系_権限のないユーザー", func(t *testing.T) {
+		_, err := attachmentService.CreateAttachment(viewerCtx, &task.CreateAttachmentRequest{TaskId: taskID, Filename: "a.txt", ContentType: "text/plain", Size: 1})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		_, err = attachmentService.DeleteAttachment(viewerCtx, &task.DeleteAttachmentRequest{Id: attachmentID})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		_, err = attachmentService.GetAttachment(otherCtx, &task.GetAttachmentRequest{Id: attachmentID})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_削除したオブジェクトは後で消える", func(t *testing.T) {
+		if _, err := attachmentService.DeleteAttachment(testUserContext(), &task.DeleteAttachmentRequest{Id: attachmentID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// ストレージの障害中は削除待ちのまま残る
+		storage.err = errors.New("unavailable")
+		if _, err := cleaner.DeleteOrphanedObjects(context.Background()); err == nil {
+			t.Fatalf("expected error")
+		}
+		storage.err = nil
+
+		deleted, err := cleaner.DeleteOrphanedObjects(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if deleted != 1 || len(storage.deleted) != 1 || storage.deleted[0] != "tasks/"+taskID+"/"+attachmentID {
+			t.Errorf("unexpected deleted objects: %v", storage.deleted)
+		}
+	})
+
+	t.Run("正常系_タスクの完全削除で添付ファイルも消える", func(t *testing.T) {
+		storage.deleted = nil
+		createAttachment(t, "a.pdf")
+		createAttachment(t, "b.pdf")
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: taskID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		// ゴミ箱にある間は復元できるようにオブジェクトを残す
+		if deleted, _ := cleaner.DeleteOrphanedObjects(context.Background()); deleted != 0 {
+			t.Errorf("expected objects to be kept while the task is in the trash, got %d", deleted)
+		}
+		if _, err := taskService.PurgeTask(testUserContext(), &task.PurgeTaskRequest{Id: taskID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		deleted, err := cleaner.DeleteOrphanedObjects(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if deleted != 2 || len(storage.deleted) != 2 {
+			t.Errorf("unexpected deleted objects: %v", storage.deleted)
+		}
+		if deleted, _ := cleaner.DeleteOrphanedObjects(context.Background()); deleted != 0 {
+			t.Errorf("expected nothing left to delete, got %d", deleted)
+		}
+	})
+
+	t.Run("異常系_ストレージが未設定", func(t *testing.T) {
+		noStorage := NewAttachmentService(attachmentRepo, infra.NewTaskRepo(db), infra.NewProjectRepo(db), nil)
+		_, err := noStorage.CreateAttachment(testUserContext(), &task.CreateAttachmentRequest{TaskId: taskID, Filename: "a.txt", ContentType: "text/plain", Size: 1})
+		if !errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("expected unavailable error, got %v", err)
+		}
+	})
+}
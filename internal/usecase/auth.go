@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	user "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type authService struct {
+	v1connect.UnimplementedAuthServiceHandler
+	userRepo   infra.UserRepo
+	apiKeyRepo infra.APIKeyRepo
+	tokens     *auth.TokenManager
+	// bootstrapOwnerEmail is the user that takes over the data without an
+	// owner when signing up, see ClaimOwnerlessData.
+	bootstrapOwnerEmail string
+}
+
+func NewAuthService(userRepo infra.UserRepo, apiKeyRepo infra.APIKeyRepo, tokens *auth.TokenManager, bootstrapOwnerEmail string) v1connect.AuthServiceHandler {
+	return &authService{
+		userRepo:            userRepo,
+		apiKeyRepo:          apiKeyRepo,
+		tokens:              tokens,
+		bootstrapOwnerEmail: bootstrapOwnerEmail,
+	}
+}
+
+// ClaimOwnerlessData gives the user with email the tasks and tags created
+// before user accounts existed. Nothing is done until the user signs up.
+func ClaimOwnerlessData(ctx context.Context, userRepo infra.UserRepo, email string) error {
+	owner, err := userRepo.GetUserByEmail(ctx, domain.GetUserByEmailParam{Email: email})
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return claimOwnerlessData(ctx, userRepo, owner.ID)
+}
+
+func claimOwnerlessData(ctx context.Context, userRepo infra.UserRepo, userID string) error {
+	tasks, tags, err := userRepo.ClaimOwnerlessData(ctx, domain.ClaimOwnerlessDataParam{UserID: userID})
+	if err != nil {
+		return err
+	}
+	if tasks > 0 || tags > 0 {
+		log.Printf("user %s took over %d tasks and %d tags without an owner", userID, tasks, tags)
+	}
+	return nil
+}
+
+func (a *authService) Signup(ctx context.Context, req *user.SignupRequest) (*user.SignupResponse, error) {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	param := domain.CreateUserParam{
+		ID:           uuid.String(),
+		Email:        req.Email,
+		PasswordHash: string(hash),
+	}
+
+	if err := a.userRepo.CreateUser(ctx, param); err != nil {
+		return nil, err
+	}
+	// 所有者のいない既存のデータは、指定されたユーザーが登録したときに引き継ぐ
+	if a.bootstrapOwnerEmail != "" && strings.EqualFold(req.Email, a.bootstrapOwnerEmail) {
+		if err := claimOwnerlessData(ctx, a.userRepo, param.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	token, expiresAt, err := a.tokens.Issue(param.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.SignupResponse{
+		UserId:      param.ID,
+		AccessToken: token,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (a *authService) Login(ctx context.Context, req *user.LoginRequest) (*user.LoginResponse, error) {
+	// メールアドレスの存在有無がわからないよう、どちらの失敗も同じエラーにする
+	invalidErr := domain.NewUnauthenticatedError("invalid email or password")
+
+	result, err := a.userRepo.GetUserByEmail(ctx, domain.GetUserByEmailParam{Email: req.Email})
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, invalidErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(result.PasswordHash), []byte(req.Password)); err != nil {
+		return nil, invalidErr
+	}
+
+	token, expiresAt, err := a.tokens.Issue(result.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.LoginResponse{
+		UserId:      result.ID,
+		AccessToken: token,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (a *authService) CreateAPIKey(ctx context.Context, req *user.CreateAPIKeyRequest) (*user.CreateAPIKeyResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	key, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	param := domain.CreateAPIKeyParam{
+		ID:        uuid.String(),
+		UserID:    userID,
+		Name:      req.Name,
+		KeyPrefix: auth.APIKeyPrefix(key),
+		KeyHash:   auth.HashAPIKey(key),
+	}
+
+	if err := a.apiKeyRepo.CreateAPIKey(ctx, param); err != nil {
+		return nil, err
+	}
+
+	return &user.CreateAPIKeyResponse{
+		ApiKey: &user.APIKey{
+			Id:        param.ID,
+			Name:      param.Name,
+			KeyPrefix: param.KeyPrefix,
+			CreatedAt: timestamppb.Now(),
+		},
+		Key: key,
+	}, nil
+}
+
+func (a *authService) ListAPIKey(ctx context.Context, req *user.ListAPIKeyRequest) (*user.ListAPIKeyResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := a.apiKeyRepo.ListAPIKey(ctx, domain.ListAPIKeyParam{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	var apiKeyList []*user.APIKey
+	for _, k := range apiKeys {
+		apiKeyList = append(apiKeyList, &user.APIKey{
+			Id:        k.ID,
+			Name:      k.Name,
+			KeyPrefix: k.KeyPrefix,
+			CreatedAt: timestamppb.New(k.CreatedAt),
+		})
+	}
+
+	return &user.ListAPIKeyResponse{
+		ApiKeys: apiKeyList,
+	}, nil
+}
+
+func (a *authService) DeleteAPIKey(ctx context.Context, req *user.DeleteAPIKeyRequest) (*user.DeleteAPIKeyResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	param := domain.DeleteAPIKeyParam{
+		ID:     req.Id,
+		UserID: userID,
+	}
+
+	if err := a.apiKeyRepo.DeleteAPIKey(ctx, param); err != nil {
+		return nil, err
+	}
+
+	return &user.DeleteAPIKeyResponse{
+		Success: true,
+	}, nil
+}
+
+// currentUserID returns the user the request was authenticated as.
+func currentUserID(ctx context.Context) (string, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return "", domain.NewUnauthenticatedError("authentication required")
+	}
+	return userID, nil
+}
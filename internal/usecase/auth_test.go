@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	user "github.com/sikigasa/task-controller/proto/v1"
+)
+
+func TestAuth(t *testing.T) {
+	db, _, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	tokens := auth.NewHS256TokenManager([]byte("test-secret"), time.Hour)
+	apiKeyRepo := infra.NewAPIKeyRepo(db)
+	authService := NewAuthService(infra.NewUserRepo(db), apiKeyRepo, tokens, "owner@example.com")
+
+	t.Run("正常系_サインアップしてログイン", func(t *testing.T) {
+		signupRes, err := authService.Signup(context.Background(), &user.SignupRequest{
+			Email:    "alice@example.com",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if userID, err := tokens.Verify(signupRes.AccessToken); err != nil || userID != signupRes.UserId {
+			t.Errorf("expected token for %s, got %s (%v)", signupRes.UserId, userID, err)
+		}
+
+		// メールアドレスの大文字小文字は区別しない
+		loginRes, err := authService.Login(context.Background(), &user.LoginRequest{
+			Email:    "Alice@Example.com",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if loginRes.UserId != signupRes.UserId {
+			t.Errorf("expected user %s, got %s", signupRes.UserId, loginRes.UserId)
+		}
+	})
+
+	t.Run("異常系_登録済みのメールアドレス", func(t *testing.T) {
+		_, err := authService.Signup(context.Background(), &user.SignupRequest{
+			Email:    "ALICE@example.com",
+			Password: "password456",
+		})
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("expected already exists error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_パスワード違い", func(t *testing.T) {
+		_, err := authService.Login(context.Background(), &user.LoginRequest{
+			Email:    "alice@example.com",
+			Password: "wrong-password",
+		})
+		if !errors.Is(err, domain.ErrUnauthenticated) {
+			t.Errorf("expected unauthenticated error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_存在しないユーザー", func(t *testing.T) {
+		_, err := authService.Login(context.Background(), &user.LoginRequest{
+			Email:    "bob@example.com",
+			Password: "password123",
+		})
+		if !errors.Is(err, domain.ErrUnauthenticated) {
+			t.Errorf("expected unauthenticated error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_APIキーの発行と失効", func(t *testing.T) {
+		signupRes, err := authService.Signup(context.Background(), &user.SignupRequest{
+			Email:    "carol@example.com",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to sign up: %v", err)
+		}
+		ctx := auth.WithUserID(context.Background(), signupRes.UserId)
+		authenticator := auth.NewAuthenticator(tokens, apiKeyRepo)
+
+		createRes, err := authService.CreateAPIKey(ctx, &user.CreateAPIKeyRequest{Name: "CI"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if userID, err := authenticator.Authenticate(context.Background(), createRes.Key); err != nil || userID != signupRes.UserId {
+			t.Errorf("expected api key for %s, got %s (%v)", signupRes.UserId, userID, err)
+		}
+
+		listRes, err := authService.ListAPIKey(ctx, &user.ListAPIKeyRequest{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(listRes.ApiKeys) != 1 || listRes.ApiKeys[0].Id != createRes.ApiKey.Id {
+			t.Errorf("expected created api key, got %v", listRes.ApiKeys)
+		}
+
+		if _, err := authService.DeleteAPIKey(ctx, &user.DeleteAPIKeyRequest{Id: createRes.ApiKey.Id}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := authenticator.Authenticate(context.Background(), createRes.Key); !errors.Is(err, auth.ErrInvalidToken) {
+			t.Errorf("expected invalid token error for revoked key, got %v", err)
+		}
+	})
+
+	t.Run("正常系_所有者のいないデータを引き継ぐ", func(t *testing.T) {
+		// ユーザー登録の導入前に作成されたタスクとタグ
+		if _, err := db.Exec(`INSERT INTO tag (id, name) VALUES ('ownerless_tag', '既存のタグ')`); err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+		_, err := db.Exec(`INSERT INTO task (id, title, position, status_id)
+			VALUES ('ownerless_task', '既存のタスク', 'V', (SELECT id FROM workflow_status WHERE project_id IS NULL AND name = 'todo'))`)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		// 登録前は何もしない
+		if err := ClaimOwnerlessData(context.Background(), infra.NewUserRepo(db), "owner@example.com"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		signupRes, err := authService.Signup(context.Background(), &user.SignupRequest{
+			Email:    "Owner@example.com",
+			Password: "password123",
+		})
+		if err != nil {
+			t.Fatalf("failed to sign up: %v", err)
+		}
+		for _, table := range []string{"task", "tag"} {
+			var ownerID string
+			if err := db.QueryRow(`SELECT owner_id FROM ` + table + ` WHERE id = 'ownerless_` + table + `'`).Scan(&ownerID); err != nil {
+				t.Fatalf("failed to get %s: %v", table, err)
+			}
+			if ownerID != signupRes.UserId {
+				t.Errorf("expected %s to be owned by %s, got %s", table, signupRes.UserId, ownerID)
+			}
+		}
+	})
+
+	t.Run("正常系_同じ名前のタグを持っていればそのタグに付け替える", func(t *testing.T) {
+		if _, err := db.Exec(`INSERT INTO tag (id, name) VALUES ('ownerless_dup_tag', '既存のタグ')`); err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+		_, err := db.Exec(`INSERT INTO task (id, title, position, status_id)
+			VALUES ('ownerless_tagged_task', 'タグ付きのタスク', 'W', (SELECT id FROM workflow_status WHERE project_id IS NULL AND name = 'todo'))`)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		if _, err := db.Exec(`INSERT INTO task_tag (task_id, tag_id) VALUES ('ownerless_tagged_task', 'ownerless_dup_tag')`); err != nil {
+			t.Fatalf("failed to tag task: %v", err)
+		}
+
+		if err := ClaimOwnerlessData(context.Background(), infra.NewUserRepo(db), "owner@example.com"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		var tagIDs []string
+		rows, err := db.Query(`SELECT tag_id FROM task_tag WHERE task_id = 'ownerless_tagged_task'`)
+		if err != nil {
+			t.Fatalf("failed to list tags: %v", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var tagID string
+			if err := rows.Scan(&tagID); err != nil {
+				t.Fatalf("failed to scan tag: %v", err)
+			}
+			tagIDs = append(tagIDs, tagID)
+		}
+		if len(tagIDs) != 1 || tagIDs[0] != "ownerless_tag" {
+			t.Errorf("expected task to be tagged with the owned tag, got %v", tagIDs)
+		}
+		var left int
+		if err := db.QueryRow(`SELECT count(*) FROM tag WHERE owner_id IS NULL`).Scan(&left); err != nil {
+			t.Fatalf("failed to count tags: %v", err)
+		}
+		if left != 0 {
+			t.Errorf("expected no tag without an owner, got %d", left)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	project "github.com/sikigasa/task-controller/proto/v1"
+)
+
+// authorizeProject returns the project if userID has at least role in it.
+// Projects the user is not a member of are reported as not found so that
+// their existence is not revealed; a role that is too weak is reported as
+// permission denied.
+func authorizeProject(ctx context.Context, projectRepo infra.ProjectRepo, projectID, userID string, role domain.ProjectRole) (*domain.Project, error) {
+	result, err := projectRepo.GetProject(ctx, domain.GetProjectParam{ID: projectID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	if !result.Role.Allows(role) {
+		return nil, domain.NewPermissionDeniedError("project", fmt.Sprintf("%s role is required for project %s", role, projectID))
+	}
+	return result, nil
+}
+
+// authorizeProjectReference reports an error unless userID may add resources
+// to the project, which needs the editor role and a project that is not
+// archived. The errors refer to the project_id field of resource.
+func authorizeProjectReference(ctx context.Context, projectRepo infra.ProjectRepo, projectID, userID, resource string) error {
+	result, err := authorizeProject(ctx, projectRepo, projectID, userID, domain.ProjectRoleEditor)
+	// メンバーでないプロジェクトは存在しないものとして扱う
+	if errors.Is(err, domain.ErrNotFound) {
+		e := domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", fmt.Sprintf("project %s not found", projectID))
+		e.Resource = resource
+		e.Field = "project_id"
+		return e
+	}
+	if err != nil {
+		return err
+	}
+	if result.ArchivedAt != nil {
+		e := domain.NewFailedPreconditionError("PROJECT_ARCHIVED", fmt.Sprintf("project %s is archived", projectID))
+		e.Resource = resource
+		e.Field = "project_id"
+		return e
+	}
+	return nil
+}
+
+// authorizeTaskWrite returns the task if userID may change it, which needs
+// the editor role for project tasks.
+func authorizeTaskWrite(ctx context.Context, taskRepo infra.TaskRepo, projectRepo infra.ProjectRepo, taskID, userID string) (*domain.Task, error) {
+	taskDetail, err := taskRepo.GetTask(ctx, domain.GetTaskParam{ID: taskID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return authorizeTaskRole(ctx, projectRepo, taskDetail, userID)
+}
+
+// authorizeDeletedTaskWrite is authorizeTaskWrite for a task in the trash.
+// Tasks that are not in the trash are reported as not found.
+func authorizeDeletedTaskWrite(ctx context.Context, taskRepo infra.TaskRepo, projectRepo infra.ProjectRepo, taskID, userID string) (*domain.Task, error) {
+	taskDetail, err := taskRepo.GetTask(ctx, domain.GetTaskParam{ID: taskID, UserID: userID, IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	if taskDetail.DeletedAt == nil {
+		return nil, domain.NewNotFoundError("task", fmt.Errorf("task %s is not in the trash", taskID))
+	}
+	return authorizeTaskRole(ctx, projectRepo, taskDetail, userID)
+}
+
+func authorizeTaskRole(ctx context.Context, projectRepo infra.ProjectRepo, taskDetail *domain.Task, userID string) (*domain.Task, error) {
+	if taskDetail.ProjectID == "" {
+		return taskDetail, nil
+	}
+	if _, err := authorizeProject(ctx, projectRepo, taskDetail.ProjectID, userID, domain.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
+	return taskDetail, nil
+}
+
+func toProtoProjectRole(role domain.ProjectRole) project.ProjectRole {
+	switch role {
+	case domain.ProjectRoleOwner:
+		return project.ProjectRole_PROJECT_ROLE_OWNER
+	case domain.ProjectRoleEditor:
+		return project.ProjectRole_PROJECT_ROLE_EDITOR
+	case domain.ProjectRoleViewer:
+		return project.ProjectRole_PROJECT_ROLE_VIEWER
+	}
+	return project.ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+func toProjectRole(role project.ProjectRole) domain.ProjectRole {
+	switch role {
+	case project.ProjectRole_PROJECT_ROLE_OWNER:
+		return domain.ProjectRoleOwner
+	case project.ProjectRole_PROJECT_ROLE_EDITOR:
+		return domain.ProjectRoleEditor
+	case project.ProjectRole_PROJECT_ROLE_VIEWER:
+		return domain.ProjectRoleViewer
+	}
+	return ""
+}
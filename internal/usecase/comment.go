@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	comment "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type commentService struct {
+	v1connect.UnimplementedCommentServiceHandler
+	commentRepo infra.CommentRepo
+	taskRepo    infra.TaskRepo
+	projectRepo infra.ProjectRepo
+}
+
+func NewCommentService(commentRepo infra.CommentRepo, taskRepo infra.TaskRepo, projectRepo infra.ProjectRepo) v1connect.CommentServiceHandler {
+	return &commentService{
+		commentRepo: commentRepo,
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+func (c *commentService) AddComment(ctx context.Context, req *comment.AddCommentRequest) (*comment.AddCommentResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// タスクを閲覧できれば誰でもコメントできる
+	if _, err := c.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: req.TaskId, UserID: userID}); err != nil {
+		return nil, err
+	}
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	param := domain.CreateCommentParam{
+		ID:       uuid.String(),
+		TaskID:   req.TaskId,
+		AuthorID: userID,
+		Body:     req.Body,
+	}
+
+	if err := c.commentRepo.CreateComment(ctx, param); err != nil {
+		return nil, err
+	}
+
+	return &comment.AddCommentResponse{
+		Id: param.ID,
+	}, nil
+}
+
+func (c *commentService) ListComments(ctx context.Context, req *comment.ListCommentsRequest) (*comment.ListCommentsResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: req.TaskId, UserID: userID}); err != nil {
+		return nil, err
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+	token, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	// 次のページの有無を判定するために1件多く取得する
+	param := domain.ListCommentParam{
+		TaskID:  req.TaskId,
+		Limit:   req.Limit + 1,
+		AfterID: token.LastID,
+	}
+
+	comments, err := c.commentRepo.ListComment(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	var nextPageToken string
+	if len(comments) > int(req.Limit) {
+		comments = comments[:req.Limit]
+		nextPageToken = encodePageToken(pageToken{LastID: comments[len(comments)-1].ID})
+	}
+	totalSize, err := c.commentRepo.CountComment(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	commentIDs := make([]string, len(comments))
+	for i, cm := range comments {
+		commentIDs[i] = cm.ID
+	}
+	revisions, err := c.commentRepo.ListCommentRevision(ctx, domain.ListCommentRevisionParam{CommentIDs: commentIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	var commentList []*comment.Comment
+	for _, cm := range comments {
+		commentList = append(commentList, toProtoComment(cm, revisions[cm.ID]))
+	}
+
+	return &comment.ListCommentsResponse{
+		Comments:      commentList,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+func (c *commentService) EditComment(ctx context.Context, req *comment.EditCommentRequest) (*comment.EditCommentResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, _, err := c.getComment(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current.AuthorID != userID {
+		return nil, domain.NewPermissionDeniedError("comment", "only the author may edit comment "+req.Id)
+	}
+
+	if err := c.commentRepo.UpdateComment(ctx, domain.UpdateCommentParam{ID: req.Id, Body: req.Body}); err != nil {
+		return nil, err
+	}
+
+	return &comment.EditCommentResponse{
+		Success: true,
+	}, nil
+}
+
+func (c *commentService) DeleteComment(ctx context.Context, req *comment.DeleteCommentRequest) (*comment.DeleteCommentResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, commentTask, err := c.getComment(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 作成者のほか、プロジェクトのオーナーは他人のコメントも削除できる
+	if current.AuthorID != userID {
+		allowed := false
+		if commentTask.ProjectID != "" {
+			result, err := c.projectRepo.GetProject(ctx, domain.GetProjectParam{ID: commentTask.ProjectID, UserID: userID})
+			if err != nil {
+				return nil, err
+			}
+			allowed = result.Role.Allows(domain.ProjectRoleOwner)
+		}
+		if !allowed {
+			return nil, domain.NewPermissionDeniedError("comment", "only the author or the project owner may delete comment "+req.Id)
+		}
+	}
+
+	if err := c.commentRepo.DeleteComment(ctx, domain.DeleteCommentParam{ID: req.Id}); err != nil {
+		return nil, err
+	}
+
+	return &comment.DeleteCommentResponse{
+		Success: true,
+	}, nil
+}
+
+// getComment returns the comment with the task it is on. Comments on tasks
+// userID cannot see are reported as not found.
+func (c *commentService) getComment(ctx context.Context, commentID, userID string) (*domain.Comment, *domain.Task, error) {
+	result, err := c.commentRepo.GetComment(ctx, domain.GetCommentParam{ID: commentID})
+	if err != nil {
+		return nil, nil, err
+	}
+	commentTask, err := c.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: result.TaskID, UserID: userID})
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil, domain.NewNotFoundError("comment", err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, commentTask, nil
+}
+
+func toProtoComment(c domain.Comment, revisions []domain.CommentRevision) *comment.Comment {
+	var history []*comment.CommentRevision
+	for _, r := range revisions {
+		history = append(history, &comment.CommentRevision{
+			Body:      r.Body,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	res := &comment.Comment{
+		Id:        c.ID,
+		TaskId:    c.TaskID,
+		AuthorId:  c.AuthorID,
+		Body:      c.Body,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		History:   history,
+	}
+	if c.EditedAt != nil {
+		res.EditedAt = timestamppb.New(*c.EditedAt)
+	}
+	return res
+}
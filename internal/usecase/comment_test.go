@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgresDriver "github.com/sikigasa/task-controller/internal/infra/driver"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestComment(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	projectService := NewProjectService(infra.NewProjectRepo(db), infra.NewProjectInvitationRepo(db), infra.NewUserRepo(db), infra.NewWorkflowRepo(db), infra.NewTaskRepo(db), infra.NewTaskTagRepo(db), infra.NewTaskHistoryRepo(db), postgresDriver.NewPostgresTransaction(db))
+	taskService := setupTestService(t, db, connStr)
+	commentService := NewCommentService(infra.NewCommentRepo(db), infra.NewTaskRepo(db), infra.NewProjectRepo(db))
+
+	createTestUser(t, db, "viewer_user")
+	createTestUser(t, db, "other_user")
+	viewerCtx := auth.WithUserID(context.Background(), "viewer_user")
+	otherCtx := auth.WithUserID(context.Background(), "other_user")
+
+	projectRes, err := projectService.CreateProject(testUserContext(), &task.CreateProjectRequest{Name: "Comments"})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	inviteTestMember(t, projectService, projectRes.Id, "viewer_user", task.ProjectRole_PROJECT_ROLE_VIEWER)
+	taskRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:     "議論するタスク",
+		LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		ProjectId: projectRes.Id,
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	taskID := taskRes.Id
+
+	var ownerCommentID, viewerCommentID string
+	t.Run("正常系_コメントの追加", func(t *testing.T) {
+		res, err := commentService.AddComment(testUserContext(), &task.AddCommentRequest{TaskId: taskID, Body: "最初のコメント"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		ownerCommentID = res.Id
+		res, err = commentService.AddComment(viewerCtx, &task.AddCommentRequest{TaskId: taskID, Body: "閲覧者のコメント"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		viewerCommentID = res.Id
+
+		getRes, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: taskID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if getRes.Task.CommentCount != 2 {
+			t.Errorf("expected 2 comments, got %d", getRes.Task.CommentCount)
+		}
+	})
+
+	t.Run("異常系_見えないタスクへのコメント", func(t *testing.T) {
+		_, err := commentService.AddComment(otherCtx, &task.AddCommentRequest{TaskId: taskID, Body: "部外者"})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		_, err = commentService.ListComments(otherCtx, &task.ListCommentsRequest{TaskId: taskID})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_編集すると履歴が残る", func(t *testing.T) {
+		for _, body := range []string{"二番目の本文", "三番目の本文"} {
+			if _, err := commentService.EditComment(testUserContext(), &task.EditCommentRequest{Id: ownerCommentID, Body: body}); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		res, err := commentService.ListComments(viewerCtx, &task.ListCommentsRequest{TaskId: taskID, Limit: 1})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.Comments) != 1 || res.TotalSize != 2 || res.NextPageToken == "" {
+			t.Fatalf("expected first page of 2 comments, got %v", res)
+		}
+		c := res.Comments[0]
+		if c.Id != ownerCommentID || c.Body != "三番目の本文" || c.AuthorId != testUserID || c.EditedAt == nil {
+			t.Errorf("unexpected comment: %v", c)
+		}
+		if len(c.History) != 2 || c.History[0].Body != "最初のコメント" || c.History[1].Body != "二番目の本文" {
+			t.Errorf("unexpected history: %v", c.History)
+		}
+
+		next, err := commentService.ListComments(viewerCtx, &task.ListCommentsRequest{TaskId: taskID, Limit: 1, PageToken: res.NextPageToken})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(next.Comments) != 1 || next.Comments[0].Id != viewerCommentID || next.Comments[0].EditedAt != nil || next.NextPageToken != "" {
+			t.Errorf("unexpected second page: %v", next)
+		}
+	})
+
+	t.Run("異常系_作成者以外は編集できない", func(t *testing.T) {
+		_, err := commentService.EditComment(testUserContext(), &task.EditCommentRequest{Id: viewerCommentID, Body: "書き換え"})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		_, err = commentService.EditComment(otherCtx, &task.EditCommentRequest{Id: viewerCommentID, Body: "書き換え"})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_削除", func(t *testing.T) {
+		_, err := commentService.DeleteComment(viewerCtx, &task.DeleteCommentRequest{Id: ownerCommentID})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		// プロジェクトのオーナーは他人のコメントも削除できる
+		if _, err := commentService.DeleteComment(testUserContext(), &task.DeleteCommentRequest{Id: viewerCommentID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		_, err = commentService.DeleteComment(testUserContext(), &task.DeleteCommentRequest{Id: viewerCommentID})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_タスクの完全削除でコメントも削除される", func(t *testing.T) {
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: taskID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := taskService.PurgeTask(testUserContext(), &task.PurgeTaskRequest{Id: taskID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		var count int
+		if err := db.QueryRow(`SELECT count(*) FROM comment`).Scan(&count); err != nil {
+			t.Fatalf("failed to count comments: %v", err)
+		}
+		if count != 0 {
+			t.Errorf("expected comments to be deleted, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestTaskDependency(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+
+	// first <- second <- third の順にブロックされる
+	firstID := createTestSubtask(t, taskService, "最初のタスク", "")
+	secondID := createTestSubtask(t, taskService, "次のタスク", "")
+	thirdID := createTestSubtask(t, taskService, "最後のタスク", "")
+
+	t.Run("正常系_依存関係の追加", func(t *testing.T) {
+		for _, req := range []*task.AddDependencyRequest{
+			{TaskId: secondID, BlockedById: firstID},
+			{TaskId: thirdID, BlockedById: secondID},
+		} {
+			if _, err := taskService.AddDependency(testUserContext(), req); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: secondID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !res.Task.Blocked {
+			t.Errorf("expected task to be blocked")
+		}
+	})
+
+	t.Run("異常系_重複した依存関係", func(t *testing.T) {
+		_, err := taskService.AddDependency(testUserContext(), &task.AddDependencyRequest{TaskId: secondID, BlockedById: firstID})
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("expected already exists error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_循環する依存関係", func(t *testing.T) {
+		for _, blockedByID := range []string{firstID, thirdID} {
+			_, err := taskService.AddDependency(testUserContext(), &task.AddDependencyRequest{TaskId: blockedByID, BlockedById: thirdID})
+			if !errors.Is(err, domain.ErrFailedPrecondition) {
+				t.Errorf("expected failed precondition error for %s, got %v", blockedByID, err)
+			}
+		}
+	})
+
+	t.Run("異常系_存在しないタスクへの依存", func(t *testing.T) {
+		_, err := taskService.AddDependency(testUserContext(), &task.AddDependencyRequest{
+			TaskId:      firstID,
+			BlockedById: "00000000-0000-0000-0000-000000000000",
+		})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_ブロックされたタスクの完了", func(t *testing.T) {
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{
+			Id:     secondID,
+			Status: "done",
+		})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_UpdateTaskのis_endでのブロックされたタスクの完了", func(t *testing.T) {
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         secondID,
+			IsEnd:      true,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"is_end"}},
+		})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_ブロックされたタスクの強制完了", func(t *testing.T) {
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{
+			Id:     secondID,
+			Status: "done",
+			Force:  true,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// 依存先が完了したのでブロックが解除される
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: thirdID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Task.Blocked {
+			t.Errorf("expected task not to be blocked")
+		}
+	})
+
+	t.Run("正常系_依存関係の削除", func(t *testing.T) {
+		if _, err := taskService.RemoveDependency(testUserContext(), &task.RemoveDependencyRequest{TaskId: secondID, BlockedById: firstID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		_, err := taskService.RemoveDependency(testUserContext(), &task.RemoveDependencyRequest{TaskId: secondID, BlockedById: firstID})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
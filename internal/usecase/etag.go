@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+// formatETag returns the etag of a task, the version quoted like an HTTP
+// entity tag.
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag returns the version of an etag returned by formatETag, or zero
+// when etag is empty.
+func parseETag(etag string) (int64, error) {
+	if etag == "" {
+		return 0, nil
+	}
+	// クォートを外した値も受け付ける
+	version, err := strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, domain.NewInvalidArgumentError("etag", "invalid etag")
+	}
+	return version, nil
+}
+
+// checkETag fails when the task has changed since the etag was read.
+func checkETag(taskID string, version, current int64) error {
+	if version == 0 || version == current {
+		return nil
+	}
+	return etagMismatchError(taskID)
+}
+
+func etagMismatchError(taskID string) error {
+	e := domain.NewConflictError("task", nil)
+	e.Reason = "ETAG_MISMATCH"
+	e.Field = "etag"
+	e.Message = "task " + taskID + " has been modified since it was read"
+	return e
+}
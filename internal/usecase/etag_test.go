@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTaskETag(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+
+	createRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:     "同時に編集するタスク",
+		LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	taskID := createRes.Id
+	getETag := func(t *testing.T) string {
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: taskID})
+		if err != nil {
+			t.Fatalf("failed to get task: %v", err)
+		}
+		return res.Task.Etag
+	}
+	staleETag := getETag(t)
+
+	t.Run("正常系_最新のETagで更新するとETagが変わる", func(t *testing.T) {
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         taskID,
+			Title:      "先に保存したタイトル",
+			Etag:       staleETag,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if etag := getETag(t); etag == staleETag || etag == "" {
+			t.Errorf("expected a new etag, got %q", etag)
+		}
+	})
+
+	t.Run("異常系_古いETagでの更新", func(t *testing.T) {
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         taskID,
+			Title:      "後から保存したタイトル",
+			Etag:       staleETag,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Fatalf("expected conflict error, got %v", err)
+		}
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: taskID})
+		if err != nil {
+			t.Fatalf("failed to get task: %v", err)
+		}
+		if res.Task.Title != "先に保存したタイトル" {
+			t.Errorf("expected the first change to be kept, got %s", res.Task.Title)
+		}
+	})
+
+	t.Run("異常系_不正なETag", func(t *testing.T) {
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         taskID,
+			Title:      "タイトル",
+			Etag:       "invalid",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_ETagなしの更新は上書きする", func(t *testing.T) {
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         taskID,
+			Title:      "上書きしたタイトル",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_古いETagでの削除", func(t *testing.T) {
+		_, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: taskID, Etag: staleETag})
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("expected conflict error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_最新のETagで削除", func(t *testing.T) {
+		_, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: taskID, Etag: getETag(t)})
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
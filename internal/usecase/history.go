@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// historyFields are the fields recorded when a task is created or deleted.
+var historyFields = []string{
+	domain.TaskFieldTitle,
+	domain.TaskFieldDescription,
+	domain.TaskFieldLimitedAt,
+	domain.TaskFieldTagIDs,
+	domain.TaskFieldParentID,
+	domain.TaskFieldPriority,
+	domain.TaskFieldRecurrence,
+}
+
+func (t *taskService) ListTaskHistory(ctx context.Context, req *task.ListTaskHistoryRequest) (*task.ListTaskHistoryResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// ゴミ箱の中のタスクも誰が削除したかを確認できるようにする
+	if _, err := t.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: req.Id, UserID: userID, IncludeDeleted: true}); err != nil {
+		return nil, err
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+	token, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	var beforeID int64
+	if token.LastID != "" {
+		if beforeID, err = strconv.ParseInt(token.LastID, 10, 64); err != nil {
+			return nil, domain.NewInvalidArgumentError("page_token", "invalid page token")
+		}
+	}
+	// 次のページの有無を判定するために1件多く取得する
+	param := domain.ListTaskHistoryParam{
+		TaskID:   req.Id,
+		Limit:    req.Limit + 1,
+		BeforeID: beforeID,
+	}
+
+	history, err := t.historyRepo.ListTaskHistory(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	var nextPageToken string
+	if len(history) > int(req.Limit) {
+		history = history[:req.Limit]
+		nextPageToken = encodePageToken(pageToken{LastID: strconv.FormatInt(history[len(history)-1].ID, 10)})
+	}
+	totalSize, err := t.historyRepo.CountTaskHistory(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	var historyList []*task.TaskHistory
+	for _, h := range history {
+		historyList = append(historyList, toProtoTaskHistory(h))
+	}
+
+	return &task.ListTaskHistoryResponse{
+		History:       historyList,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+// taskRecorder writes what follows from a change to a task: its history and
+// the next occurrence of a recurring task. It is shared by the services that
+// change tasks.
+type taskRecorder struct {
+	taskRepo    infra.TaskRepo
+	taskTagRepo infra.TaskTagRepo
+	historyRepo infra.TaskHistoryRepo
+}
+
+func newTaskRecorder(taskRepo infra.TaskRepo, taskTagRepo infra.TaskTagRepo, historyRepo infra.TaskHistoryRepo) taskRecorder {
+	return taskRecorder{
+		taskRepo:    taskRepo,
+		taskTagRepo: taskTagRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+// recordHistory adds a change to the history of a task in tx. Updates that
+// change nothing are not recorded.
+func (t taskRecorder) recordHistory(ctx context.Context, tx *sql.Tx, taskID, userID string, action domain.TaskHistoryAction, changes []domain.FieldChange) error {
+	if action == domain.TaskHistoryUpdated && len(changes) == 0 {
+		return nil
+	}
+	return t.historyRepo.CreateTaskHistory(ctx, tx, domain.CreateTaskHistoryParam{
+		TaskID:  taskID,
+		ActorID: userID,
+		Action:  action,
+		Changes: changes,
+	})
+}
+
+// taskFieldValues returns the fields of a task in the form they are recorded
+// in the history.
+func taskFieldValues(t domain.Task, tagIDs []string) map[string]string {
+	tagIDs = slices.Clone(tagIDs)
+	tagIDs = slices.DeleteFunc(tagIDs, func(id string) bool { return id == "" })
+	slices.Sort(tagIDs)
+	values := map[string]string{
+		domain.TaskFieldTitle:       t.Title,
+		domain.TaskFieldDescription: t.Description,
+		domain.TaskFieldTagIDs:      strings.Join(tagIDs, ","),
+		domain.TaskFieldParentID:    t.ParentID,
+		domain.TaskFieldRecurrence:  t.Recurrence,
+	}
+	if !t.LimitedAt.IsZero() {
+		values[domain.TaskFieldLimitedAt] = t.LimitedAt.UTC().Format(time.RFC3339)
+	}
+	if t.Priority != domain.TaskPriorityNone {
+		values[domain.TaskFieldPriority] = toProtoTaskPriority(t.Priority).String()
+	}
+	return values
+}
+
+// diffTaskFields lists the fields whose values differ, in the order of fields.
+func diffTaskFields(fields []string, before, after map[string]string) []domain.FieldChange {
+	var changes []domain.FieldChange
+	for _, field := range fields {
+		if before[field] != after[field] {
+			changes = append(changes, domain.FieldChange{Field: field, Before: before[field], After: after[field]})
+		}
+	}
+	return changes
+}
+
+func tagIDsOf(tags []domain.Tag) []string {
+	ids := make([]string, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ID
+	}
+	return ids
+}
+
+func toProtoTaskHistory(h domain.TaskHistory) *task.TaskHistory {
+	var changes []*task.FieldChange
+	for _, c := range h.Changes {
+		changes = append(changes, &task.FieldChange{
+			Field:  c.Field,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+	return &task.TaskHistory{
+		Id:        strconv.FormatInt(h.ID, 10),
+		TaskId:    h.TaskID,
+		ActorId:   h.ActorID,
+		Action:    toProtoTaskHistoryAction(h.Action),
+		Changes:   changes,
+		CreatedAt: timestamppb.New(h.CreatedAt),
+	}
+}
+
+func toProtoTaskHistoryAction(action domain.TaskHistoryAction) task.TaskHistory_Action {
+	switch action {
+	case domain.TaskHistoryCreated:
+		return task.TaskHistory_ACTION_CREATED
+	case domain.TaskHistoryUpdated:
+		return task.TaskHistory_ACTION_UPDATED
+	case domain.TaskHistoryDeleted:
+		return task.TaskHistory_ACTION_DELETED
+	case domain.TaskHistoryRestored:
+		return task.TaskHistory_ACTION_RESTORED
+	}
+	return task.TaskHistory_ACTION_UNSPECIFIED
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTaskHistory(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+	createTestTag(t, db, "history_tag1", "履歴タグ1")
+	createTestTag(t, db, "history_tag2", "履歴タグ2")
+	createTestUser(t, db, "other_user")
+
+	limitedAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	createRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:     "履歴のあるタスク",
+		LimitedAt: timestamppb.New(limitedAt),
+		TagIds:    []string{"history_tag1"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	taskID := createRes.Id
+	subtaskID := createTestSubtask(t, taskService, "サブタスク", taskID)
+
+	t.Run("正常系_変更したフィールドだけが記録される", func(t *testing.T) {
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         taskID,
+			Title:      "新しいタイトル",
+			TagIds:     []string{"history_tag2", "history_tag1"},
+			LimitedAt:  timestamppb.New(limitedAt),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "tag_ids", "limited_at"}},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		res, err := taskService.ListTaskHistory(testUserContext(), &task.ListTaskHistoryRequest{Id: taskID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.History) != 2 || res.TotalSize != 2 {
+			t.Fatalf("expected 2 changes, got %v", res.History)
+		}
+		updated := res.History[0]
+		if updated.Action != task.TaskHistory_ACTION_UPDATED || updated.ActorId != testUserID || updated.CreatedAt == nil {
+			t.Errorf("unexpected change: %v", updated)
+		}
+		want := []*task.FieldChange{
+			{Field: "title", Before: "履歴のあるタスク", After: "新しいタイトル"},
+			{Field: "tag_ids", Before: "history_tag1", After: "history_tag1,history_tag2"},
+		}
+		if len(updated.Changes) != len(want) {
+			t.Fatalf("expected %v, got %v", want, updated.Changes)
+		}
+		for i, c := range updated.Changes {
+			if c.Field != want[i].Field || c.Before != want[i].Before || c.After != want[i].After {
+				t.Errorf("expected %v, got %v", want[i], c)
+			}
+		}
+
+		created := res.History[1]
+		if created.Action != task.TaskHistory_ACTION_CREATED {
+			t.Errorf("expected created, got %v", created.Action)
+		}
+		for _, c := range created.Changes {
+			if c.Field == "limited_at" && (c.Before != "" || c.After != "2030-01-01T09:00:00Z") {
+				t.Errorf("unexpected limited_at change: %v", c)
+			}
+		}
+	})
+
+	t.Run("正常系_ステータスの変更とサブタスクの一括完了", func(t *testing.T) {
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{
+			Id:                taskID,
+			Status:            "done",
+			SubtaskCompletion: task.TransitionTaskRequest_SUBTASK_COMPLETION_CASCADE,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		for _, id := range []string{taskID, subtaskID} {
+			res, err := taskService.ListTaskHistory(testUserContext(), &task.ListTaskHistoryRequest{Id: id, Limit: 1})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			c := res.History[0].Changes
+			if len(c) != 1 || c[0].Field != "status" || c[0].Before != "todo" || c[0].After != "done" {
+				t.Errorf("unexpected changes of %s: %v", id, c)
+			}
+		}
+	})
+
+	t.Run("正常系_ページング", func(t *testing.T) {
+		res, err := taskService.ListTaskHistory(testUserContext(), &task.ListTaskHistoryRequest{Id: taskID, Limit: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.History) != 2 || res.TotalSize != 3 || res.NextPageToken == "" {
+			t.Fatalf("expected first page of 3 changes, got %v", res)
+		}
+		next, err := taskService.ListTaskHistory(testUserContext(), &task.ListTaskHistoryRequest{Id: taskID, Limit: 2, PageToken: res.NextPageToken})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(next.History) != 1 || next.History[0].Action != task.TaskHistory_ACTION_CREATED || next.NextPageToken != "" {
+			t.Errorf("unexpected second page: %v", next)
+		}
+	})
+
+	t.Run("異常系_見えないタスクの履歴", func(t *testing.T) {
+		_, err := taskService.ListTaskHistory(auth.WithUserID(context.Background(), "other_user"), &task.ListTaskHistoryRequest{Id: taskID})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_履歴は書き換えられない", func(t *testing.T) {
+		if _, err := db.Exec(`UPDATE task_event SET actor_id = 'other_user'`); err == nil {
+			t.Errorf("expected update to be rejected")
+		}
+		if _, err := db.Exec(`DELETE FROM task_event`); err == nil {
+			t.Errorf("expected delete to be rejected")
+		}
+	})
+
+	t.Run("正常系_削除後も履歴が残る", func(t *testing.T) {
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: taskID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		var action, changes string
+		err := db.QueryRow(`SELECT action, changes::text FROM task_event WHERE task_id = $1 ORDER BY id DESC LIMIT 1`, taskID).Scan(&action, &changes)
+		if err != nil {
+			t.Fatalf("failed to get history: %v", err)
+		}
+		if action != "deleted" || changes == "[]" {
+			t.Errorf("expected deletion with the last values, got %s %s", action, changes)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTaskOrdering(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+
+	var ids []string
+	for _, p := range []task.TaskPriority{task.TaskPriority_TASK_PRIORITY_LOW, task.TaskPriority_TASK_PRIORITY_URGENT, task.TaskPriority_TASK_PRIORITY_UNSPECIFIED} {
+		res, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:     "並び順テスト",
+			LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+			Priority:  p,
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		ids = append(ids, res.Id)
+	}
+	first, second, third := ids[0], ids[1], ids[2]
+
+	listIDs := func(t *testing.T, orderBy string) []string {
+		res, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{OrderBy: orderBy})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		var ids []string
+		for _, tk := range res.Tasks {
+			ids = append(ids, tk.Id)
+		}
+		return ids
+	}
+	expectOrder := func(t *testing.T, orderBy string, want ...string) {
+		got := listIDs(t, orderBy)
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	}
+
+	t.Run("正常系_作成順に末尾へ追加", func(t *testing.T) {
+		expectOrder(t, "position", first, second, third)
+	})
+
+	t.Run("正常系_二つのタスクの間に移動", func(t *testing.T) {
+		if _, err := taskService.MoveTask(testUserContext(), &task.MoveTaskRequest{Id: third, AfterId: first, BeforeId: second}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expectOrder(t, "position", first, third, second)
+	})
+
+	t.Run("正常系_片方の隣だけを指定", func(t *testing.T) {
+		if _, err := taskService.MoveTask(testUserContext(), &task.MoveTaskRequest{Id: first, AfterId: second}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expectOrder(t, "position", third, second, first)
+
+		if _, err := taskService.MoveTask(testUserContext(), &task.MoveTaskRequest{Id: first, BeforeId: second}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expectOrder(t, "position desc", second, first, third)
+	})
+
+	t.Run("異常系_隣の順序が逆", func(t *testing.T) {
+		_, err := taskService.MoveTask(testUserContext(), &task.MoveTaskRequest{Id: third, AfterId: second, BeforeId: first})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_隣の指定なし", func(t *testing.T) {
+		_, err := taskService.MoveTask(testUserContext(), &task.MoveTaskRequest{Id: third})
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_優先度順", func(t *testing.T) {
+		expectOrder(t, "priority desc", second, first, third)
+
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         third,
+			Priority:   task.TaskPriority_TASK_PRIORITY_HIGH,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"priority"}},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expectOrder(t, "priority desc", second, third, first)
+	})
+}
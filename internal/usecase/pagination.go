@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+)
+
+// pageToken is the decoded form of the opaque page_token/next_page_token
+// strings. It holds the key of the last row of the previous page and the
+// order it was listed in.
+type pageToken struct {
+	LastID    string `json:"id"`
+	LastValue string `json:"value,omitempty"`
+	OrderBy   string `json:"order_by,omitempty"`
+}
+
+func encodePageToken(token pageToken) string {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var token pageToken
+	if s == "" {
+		return token, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, domain.NewInvalidArgumentError("page_token", "invalid page token")
+	}
+	if err := json.Unmarshal(b, &token); err != nil || token.LastID == "" {
+		return token, domain.NewInvalidArgumentError("page_token", "invalid page token")
+	}
+	return token, nil
+}
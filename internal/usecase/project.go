@@ -0,0 +1,495 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgres "github.com/sikigasa/task-controller/internal/infra/driver"
+	project "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type projectService struct {
+	v1connect.UnimplementedProjectServiceHandler
+	projectRepo    infra.ProjectRepo
+	invitationRepo infra.ProjectInvitationRepo
+	userRepo       infra.UserRepo
+	workflowRepo   infra.WorkflowRepo
+	// tasks completes the tasks whose status becomes an end status.
+	tasks taskRecorder
+	tx    postgres.Transaction
+}
+
+func NewProjectService(projectRepo infra.ProjectRepo, invitationRepo infra.ProjectInvitationRepo, userRepo infra.UserRepo, workflowRepo infra.WorkflowRepo, taskRepo infra.TaskRepo, taskTagRepo infra.TaskTagRepo, historyRepo infra.TaskHistoryRepo, tx postgres.Transaction) v1connect.ProjectServiceHandler {
+	return &projectService{
+		projectRepo:    projectRepo,
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		workflowRepo:   workflowRepo,
+		tasks:          newTaskRecorder(taskRepo, taskTagRepo, historyRepo),
+		tx:             tx,
+	}
+}
+
+func (p *projectService) CreateProject(ctx context.Context, req *project.CreateProjectRequest) (*project.CreateProjectResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		param := domain.CreateProjectParam{
+			ID:      uuid.String(),
+			OwnerID: userID,
+			Name:    req.Name,
+		}
+		if err := p.projectRepo.CreateProject(ctx, tx, param); err != nil {
+			return err
+		}
+
+		// 作成者はオーナーとして最初のメンバーになる
+		if err := p.projectRepo.CreateProjectMember(ctx, tx, domain.CreateProjectMemberParam{
+			ProjectID: param.ID,
+			UserID:    userID,
+			Role:      domain.ProjectRoleOwner,
+		}); err != nil {
+			return err
+		}
+		return p.workflowRepo.CreateProjectWorkflow(ctx, tx, domain.CreateProjectWorkflowParam{ProjectID: param.ID})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &project.CreateProjectResponse{
+		Id: uuid.String(),
+	}, nil
+}
+
+func (p *projectService) ListProject(ctx context.Context, req *project.ListProjectRequest) (*project.ListProjectResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+	token, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	// 次のページの有無を判定するために1件多く取得する
+	param := domain.ListProjectParam{
+		UserID:          userID,
+		IncludeArchived: req.IncludeArchived,
+		Limit:           req.Limit + 1,
+		Offset:          req.Offset,
+		AfterID:         token.LastID,
+	}
+	if token.LastID != "" {
+		param.Offset = 0
+	}
+
+	projects, err := p.projectRepo.ListProject(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	var nextPageToken string
+	if len(projects) > int(req.Limit) {
+		projects = projects[:req.Limit]
+		nextPageToken = encodePageToken(pageToken{LastID: projects[len(projects)-1].ID})
+	}
+	totalSize, err := p.projectRepo.CountProject(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectList []*project.Project
+	for _, pj := range projects {
+		projectList = append(projectList, toProtoProject(pj))
+	}
+
+	return &project.ListProjectResponse{
+		Projects:      projectList,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+func (p *projectService) RenameProject(ctx context.Context, req *project.RenameProjectRequest) (*project.RenameProjectResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeProject(ctx, p.projectRepo, req.Id, userID, domain.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	param := domain.RenameProjectParam{
+		ID:     req.Id,
+		UserID: userID,
+		Name:   req.Name,
+	}
+
+	if err := p.projectRepo.RenameProject(ctx, param); err != nil {
+		return nil, err
+	}
+
+	return &project.RenameProjectResponse{
+		Success: true,
+	}, nil
+}
+
+func (p *projectService) ArchiveProject(ctx context.Context, req *project.ArchiveProjectRequest) (*project.ArchiveProjectResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeProject(ctx, p.projectRepo, req.Id, userID, domain.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	param := domain.ArchiveProjectParam{
+		ID:     req.Id,
+		UserID: userID,
+	}
+
+	if err := p.projectRepo.ArchiveProject(ctx, param); err != nil {
+		return nil, err
+	}
+
+	return &project.ArchiveProjectResponse{
+		Success: true,
+	}, nil
+}
+
+func (p *projectService) ListProjectMember(ctx context.Context, req *project.ListProjectMemberRequest) (*project.ListProjectMemberResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeProject(ctx, p.projectRepo, req.ProjectId, userID, domain.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	members, err := p.projectRepo.ListProjectMember(ctx, domain.ListProjectMemberParam{ProjectID: req.ProjectId})
+	if err != nil {
+		return nil, err
+	}
+
+	var memberList []*project.ProjectMember
+	for _, m := range members {
+		memberList = append(memberList, &project.ProjectMember{
+			UserId:    m.UserID,
+			Role:      toProtoProjectRole(m.Role),
+			CreatedAt: timestamppb.New(m.CreatedAt),
+		})
+	}
+
+	return &project.ListProjectMemberResponse{
+		Members: memberList,
+	}, nil
+}
+
+func (p *projectService) InviteMember(ctx context.Context, req *project.InviteMemberRequest) (*project.InviteMemberResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeProject(ctx, p.projectRepo, req.ProjectId, userID, domain.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	invitee, err := p.userRepo.GetUserByEmail(ctx, domain.GetUserByEmailParam{Email: req.Email})
+	if err != nil {
+		return nil, err
+	}
+	_, err = p.projectRepo.GetProject(ctx, domain.GetProjectParam{ID: req.ProjectId, UserID: invitee.ID})
+	if err == nil {
+		return nil, domain.NewAlreadyExistsError("project_member", "email", nil)
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	param := domain.CreateProjectInvitationParam{
+		ID:        uuid.String(),
+		ProjectID: req.ProjectId,
+		UserID:    invitee.ID,
+		Role:      toProjectRole(req.Role),
+		InvitedBy: userID,
+	}
+
+	if err := p.invitationRepo.CreateProjectInvitation(ctx, param); err != nil {
+		return nil, err
+	}
+
+	return &project.InviteMemberResponse{
+		Id: param.ID,
+	}, nil
+}
+
+func (p *projectService) ListInvitation(ctx context.Context, req *project.ListInvitationRequest) (*project.ListInvitationResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := p.invitationRepo.ListProjectInvitation(ctx, domain.ListProjectInvitationParam{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	var invitationList []*project.Invitation
+	for _, i := range invitations {
+		invitationList = append(invitationList, &project.Invitation{
+			Id:        i.ID,
+			ProjectId: i.ProjectID,
+			Role:      toProtoProjectRole(i.Role),
+			InvitedBy: i.InvitedBy,
+			CreatedAt: timestamppb.New(i.CreatedAt),
+		})
+	}
+
+	return &project.ListInvitationResponse{
+		Invitations: invitationList,
+	}, nil
+}
+
+func (p *projectService) AcceptInvitation(ctx context.Context, req *project.AcceptInvitationRequest) (*project.AcceptInvitationResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invitation, err := p.invitationRepo.GetProjectInvitation(ctx, domain.GetProjectInvitationParam{ID: req.Id, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		// 招待が取り消されていれば受け付けない
+		if err := p.invitationRepo.DeleteProjectInvitation(ctx, tx, domain.DeleteProjectInvitationParam{
+			ProjectID: invitation.ProjectID,
+			UserID:    userID,
+		}); err != nil {
+			return err
+		}
+		return p.projectRepo.CreateProjectMember(ctx, tx, domain.CreateProjectMemberParam{
+			ProjectID: invitation.ProjectID,
+			UserID:    userID,
+			Role:      invitation.Role,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &project.AcceptInvitationResponse{
+		ProjectId: invitation.ProjectID,
+	}, nil
+}
+
+func (p *projectService) RevokeMember(ctx context.Context, req *project.RevokeMemberRequest) (*project.RevokeMemberResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := authorizeProject(ctx, p.projectRepo, req.ProjectId, userID, domain.ProjectRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == result.OwnerID {
+		e := domain.NewFailedPreconditionError("OWNER_NOT_REVOCABLE", "the project owner cannot be removed")
+		e.Resource = "project_member"
+		e.Field = "user_id"
+		return nil, e
+	}
+
+	err = p.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		// メンバーと保留中の招待のどちらかがあれば取り消す
+		memberErr := p.projectRepo.DeleteProjectMember(ctx, tx, domain.DeleteProjectMemberParam{
+			ProjectID: req.ProjectId,
+			UserID:    req.UserId,
+		})
+		if memberErr != nil && !errors.Is(memberErr, domain.ErrNotFound) {
+			return memberErr
+		}
+		invitationErr := p.invitationRepo.DeleteProjectInvitation(ctx, tx, domain.DeleteProjectInvitationParam{
+			ProjectID: req.ProjectId,
+			UserID:    req.UserId,
+		})
+		if invitationErr != nil && !errors.Is(invitationErr, domain.ErrNotFound) {
+			return invitationErr
+		}
+		if memberErr != nil && invitationErr != nil {
+			return memberErr
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &project.RevokeMemberResponse{
+		Success: true,
+	}, nil
+}
+
+func (p *projectService) GetWorkflow(ctx context.Context, req *project.GetWorkflowRequest) (*project.GetWorkflowResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeProject(ctx, p.projectRepo, req.ProjectId, userID, domain.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	param := domain.ListWorkflowParam{ProjectID: req.ProjectId}
+
+	statuses, err := p.workflowRepo.ListWorkflowStatus(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := p.workflowRepo.ListWorkflowTransition(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := &project.Workflow{}
+	for _, s := range statuses {
+		workflow.Statuses = append(workflow.Statuses, &project.WorkflowStatus{
+			Name:  s.Name,
+			IsEnd: s.IsEnd,
+		})
+	}
+	for _, t := range transitions {
+		workflow.Transitions = append(workflow.Transitions, &project.WorkflowTransition{
+			FromStatus: t.From,
+			ToStatus:   t.To,
+		})
+	}
+
+	return &project.GetWorkflowResponse{
+		Workflow: workflow,
+	}, nil
+}
+
+func (p *projectService) UpdateWorkflow(ctx context.Context, req *project.UpdateWorkflowRequest) (*project.UpdateWorkflowResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeProject(ctx, p.projectRepo, req.ProjectId, userID, domain.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	param, err := toReplaceWorkflowParam(req.ProjectId, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if err := p.workflowRepo.ReplaceWorkflow(ctx, tx, param); err != nil {
+			return err
+		}
+		// ゴミ箱のタスクは復元したときに反映する
+		return p.tasks.syncTaskEnd(ctx, tx, req.ProjectId, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &project.UpdateWorkflowResponse{
+		Success: true,
+	}, nil
+}
+
+// syncTaskEnd completes or reopens the tasks of a project whose status no
+// longer matches their is_end, recording the change and creating the next
+// occurrence of recurring tasks as TransitionTask does.
+func (t taskRecorder) syncTaskEnd(ctx context.Context, tx *sql.Tx, projectID, userID string) error {
+	changed, err := t.taskRepo.SyncTaskEnd(ctx, tx, domain.SyncTaskEndParam{ProjectID: projectID})
+	if err != nil {
+		return err
+	}
+	for _, current := range changed {
+		err := t.recordHistory(ctx, tx, current.ID, userID, domain.TaskHistoryUpdated, []domain.FieldChange{
+			{Field: domain.TaskFieldIsEnd, Before: strconv.FormatBool(!current.IsEnd), After: strconv.FormatBool(current.IsEnd)},
+		})
+		if err != nil {
+			return err
+		}
+		if current.IsEnd && current.Recurrence != "" {
+			if _, err := t.createNextOccurrence(ctx, tx, &current, userID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// toReplaceWorkflowParam checks the parts of a workflow the request
+// validation cannot: status names must be unique, transitions must refer to
+// them and new tasks must not start out done.
+func toReplaceWorkflowParam(projectID string, workflow *project.Workflow) (domain.ReplaceWorkflowParam, error) {
+	param := domain.ReplaceWorkflowParam{ProjectID: projectID}
+	names := map[string]bool{}
+	for i, s := range workflow.Statuses {
+		if names[s.Name] {
+			return param, domain.NewInvalidArgumentError(fmt.Sprintf("workflow.statuses[%d].name", i), "status "+s.Name+" is listed more than once")
+		}
+		names[s.Name] = true
+		id, err := uuid.NewV7()
+		if err != nil {
+			return param, err
+		}
+		param.Statuses = append(param.Statuses, domain.WorkflowStatus{
+			ID:        id.String(),
+			ProjectID: projectID,
+			Name:      s.Name,
+			IsEnd:     s.IsEnd,
+			Position:  int32(i),
+		})
+	}
+	if workflow.Statuses[0].IsEnd {
+		return param, domain.NewInvalidArgumentError("workflow.statuses[0].is_end", "new tasks must not start in an end status")
+	}
+
+	transitions := map[domain.WorkflowTransition]bool{}
+	for i, t := range workflow.Transitions {
+		transition := domain.WorkflowTransition{From: t.FromStatus, To: t.ToStatus}
+		field := fmt.Sprintf("workflow.transitions[%d]", i)
+		if !names[t.FromStatus] || !names[t.ToStatus] {
+			return param, domain.NewInvalidArgumentError(field, "transitions must refer to statuses of the workflow")
+		}
+		if t.FromStatus == t.ToStatus || transitions[transition] {
+			return param, domain.NewInvalidArgumentError(field, "transitions must be unique and change the status")
+		}
+		transitions[transition] = true
+		param.Transitions = append(param.Transitions, transition)
+	}
+	return param, nil
+}
+
+func toProtoProject(p domain.Project) *project.Project {
+	res := &project.Project{
+		Id:        p.ID,
+		Name:      p.Name,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+		Role:      toProtoProjectRole(p.Role),
+	}
+	if p.ArchivedAt != nil {
+		res.ArchivedAt = timestamppb.New(*p.ArchivedAt)
+	}
+	return res
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgresDriver "github.com/sikigasa/task-controller/internal/infra/driver"
+	project "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestProject(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	projectService := NewProjectService(infra.NewProjectRepo(db), infra.NewProjectInvitationRepo(db), infra.NewUserRepo(db), infra.NewWorkflowRepo(db), infra.NewTaskRepo(db), infra.NewTaskTagRepo(db), infra.NewTaskHistoryRepo(db), postgresDriver.NewPostgresTransaction(db))
+	taskService := setupTestService(t, db, connStr)
+	tagService := NewTagService(infra.NewTagRepo(db), infra.NewProjectRepo(db))
+
+	createTestUser(t, db, "editor_user")
+	createTestUser(t, db, "viewer_user")
+	createTestUser(t, db, "other_user")
+	editorCtx := auth.WithUserID(context.Background(), "editor_user")
+	viewerCtx := auth.WithUserID(context.Background(), "viewer_user")
+	otherCtx := auth.WithUserID(context.Background(), "other_user")
+
+	createRes, err := projectService.CreateProject(testUserContext(), &project.CreateProjectRequest{Name: "Release"})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	projectID := createRes.Id
+
+	t.Run("正常系_招待を承認してメンバーになる", func(t *testing.T) {
+		inviteTestMember(t, projectService, projectID, "editor_user", project.ProjectRole_PROJECT_ROLE_EDITOR)
+		inviteTestMember(t, projectService, projectID, "viewer_user", project.ProjectRole_PROJECT_ROLE_VIEWER)
+
+		res, err := projectService.ListProjectMember(viewerCtx, &project.ListProjectMemberRequest{ProjectId: projectID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		roles := map[string]project.ProjectRole{}
+		for _, m := range res.Members {
+			roles[m.UserId] = m.Role
+		}
+		if roles[testUserID] != project.ProjectRole_PROJECT_ROLE_OWNER ||
+			roles["editor_user"] != project.ProjectRole_PROJECT_ROLE_EDITOR ||
+			roles["viewer_user"] != project.ProjectRole_PROJECT_ROLE_VIEWER {
+			t.Errorf("unexpected members: %v", res.Members)
+		}
+
+		listRes, err := projectService.ListProject(editorCtx, &project.ListProjectRequest{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(listRes.Projects) != 1 || listRes.Projects[0].Role != project.ProjectRole_PROJECT_ROLE_EDITOR {
+			t.Errorf("expected project with editor role, got %v", listRes.Projects)
+		}
+	})
+
+	t.Run("異常系_メンバーを重複して招待", func(t *testing.T) {
+		_, err := projectService.InviteMember(testUserContext(), &project.InviteMemberRequest{
+			ProjectId: projectID,
+			Email:     "editor_user@example.com",
+			Role:      project.ProjectRole_PROJECT_ROLE_VIEWER,
+		})
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("expected already exists error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_オーナー以外は招待できない", func(t *testing.T) {
+		_, err := projectService.InviteMember(editorCtx, &project.InviteMemberRequest{
+			ProjectId: projectID,
+			Email:     "other_user@example.com",
+			Role:      project.ProjectRole_PROJECT_ROLE_EDITOR,
+		})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_ロールに応じたタスクの操作", func(t *testing.T) {
+		taskRes, err := taskService.CreateTask(editorCtx, &project.CreateTaskRequest{
+			Title:     "Project Task",
+			LimitedAt: timestamppb.Now(),
+			ProjectId: projectID,
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		getRes, err := taskService.GetTask(viewerCtx, &project.GetTaskRequest{Id: taskRes.Id})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if getRes.Task.ProjectId != projectID {
+			t.Errorf("expected project %s, got %s", projectID, getRes.Task.ProjectId)
+		}
+		listRes, err := taskService.ListTask(viewerCtx, &project.ListTaskRequest{
+			Filter: &project.TaskFilter{ProjectId: projectID},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(listRes.Tasks) != 1 || listRes.Tasks[0].Id != taskRes.Id {
+			t.Errorf("expected project task, got %v", listRes.Tasks)
+		}
+
+		// 閲覧者は変更できない
+		_, err = taskService.UpdateTask(viewerCtx, &project.UpdateTaskRequest{
+			Id:        taskRes.Id,
+			Title:     "Viewer Update",
+			LimitedAt: timestamppb.Now(),
+		})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		if _, err := taskService.DeleteTask(viewerCtx, &project.DeleteTaskRequest{Id: taskRes.Id}); !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		_, err = taskService.CreateTask(viewerCtx, &project.CreateTaskRequest{
+			Title:     "Viewer Task",
+			LimitedAt: timestamppb.Now(),
+			ProjectId: projectID,
+		})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+
+		// メンバー以外からは存在しないものとして扱う
+		if _, err := taskService.GetTask(otherCtx, &project.GetTaskRequest{Id: taskRes.Id}); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		if _, err := taskService.DeleteTask(otherCtx, &project.DeleteTaskRequest{Id: taskRes.Id}); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+
+		if _, err := taskService.DeleteTask(editorCtx, &project.DeleteTaskRequest{Id: taskRes.Id}); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_プロジェクトのタグを共有", func(t *testing.T) {
+		tagRes, err := tagService.CreateTag(editorCtx, &project.CreateTagRequest{Name: "Shared", ProjectId: projectID})
+		if err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+
+		getRes, err := tagService.GetTag(viewerCtx, &project.GetTagRequest{Id: tagRes.Id})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if getRes.Tag.ProjectId != projectID {
+			t.Errorf("expected project %s, got %s", projectID, getRes.Tag.ProjectId)
+		}
+		if _, err := tagService.UpdateTag(viewerCtx, &project.UpdateTagRequest{Id: tagRes.Id, Name: "Renamed"}); !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		if _, err := tagService.DeleteTag(viewerCtx, &project.DeleteTagRequest{Id: tagRes.Id}); !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		if _, err := tagService.GetTag(otherCtx, &project.GetTagRequest{Id: tagRes.Id}); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_プロジェクト名を変更", func(t *testing.T) {
+		if _, err := projectService.RenameProject(testUserContext(), &project.RenameProjectRequest{Id: projectID, Name: "Release 2"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		_, err := projectService.RenameProject(editorCtx, &project.RenameProjectRequest{Id: projectID, Name: "Editor Rename"})
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("expected permission denied error, got %v", err)
+		}
+		_, err = projectService.RenameProject(otherCtx, &project.RenameProjectRequest{Id: projectID, Name: "Hijacked"})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_メンバーの取り消し", func(t *testing.T) {
+		if _, err := projectService.RevokeMember(testUserContext(), &project.RevokeMemberRequest{ProjectId: projectID, UserId: "viewer_user"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := projectService.ListProjectMember(viewerCtx, &project.ListProjectMemberRequest{ProjectId: projectID}); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+
+		_, err := projectService.RevokeMember(testUserContext(), &project.RevokeMemberRequest{ProjectId: projectID, UserId: testUserID})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+		_, err = projectService.RevokeMember(testUserContext(), &project.RevokeMemberRequest{ProjectId: projectID, UserId: "other_user"})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_アーカイブしたプロジェクト", func(t *testing.T) {
+		if _, err := projectService.ArchiveProject(testUserContext(), &project.ArchiveProjectRequest{Id: projectID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		res, err := projectService.ListProject(testUserContext(), &project.ListProjectRequest{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.Projects) != 0 {
+			t.Errorf("expected archived project to be hidden, got %v", res.Projects)
+		}
+		res, err = projectService.ListProject(testUserContext(), &project.ListProjectRequest{IncludeArchived: true})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.Projects) != 1 || res.Projects[0].ArchivedAt == nil {
+			t.Errorf("expected archived project, got %v", res.Projects)
+		}
+
+		_, err = taskService.CreateTask(editorCtx, &project.CreateTaskRequest{
+			Title:     "Late Task",
+			LimitedAt: timestamppb.Now(),
+			ProjectId: projectID,
+		})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+}
+
+// inviteTestMember invites userID to the project as testUserID and accepts the
+// invitation as userID.
+func inviteTestMember(t *testing.T, projectService v1connect.ProjectServiceHandler, projectID, userID string, role project.ProjectRole) {
+	inviteRes, err := projectService.InviteMember(testUserContext(), &project.InviteMemberRequest{
+		ProjectId: projectID,
+		Email:     userID + "@example.com",
+		Role:      role,
+	})
+	if err != nil {
+		t.Fatalf("failed to invite %s: %v", userID, err)
+	}
+
+	ctx := auth.WithUserID(context.Background(), userID)
+	listRes, err := projectService.ListInvitation(ctx, &project.ListInvitationRequest{})
+	if err != nil {
+		t.Fatalf("failed to list invitations: %v", err)
+	}
+	if len(listRes.Invitations) != 1 || listRes.Invitations[0].Id != inviteRes.Id {
+		t.Fatalf("expected invitation %s, got %v", inviteRes.Id, listRes.Invitations)
+	}
+	if _, err := projectService.AcceptInvitation(ctx, &project.AcceptInvitationRequest{Id: inviteRes.Id}); err != nil {
+		t.Fatalf("failed to accept invitation: %v", err)
+	}
+}
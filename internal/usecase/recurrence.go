@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/teambition/rrule-go"
+)
+
+// parseRecurrence checks an RRULE and returns it in a normalized form. The
+// DTSTART of the rule is taken from the task, so it cannot be part of it.
+func parseRecurrence(rule string) (string, error) {
+	opt, err := rrule.StrToROption(strings.TrimPrefix(rule, "RRULE:"))
+	if err != nil {
+		return "", domain.NewInvalidArgumentError("recurrence", err.Error())
+	}
+	if !opt.Dtstart.IsZero() {
+		return "", domain.NewInvalidArgumentError("recurrence", "DTSTART is taken from limited_at")
+	}
+	// 細かすぎる繰り返しは次の回の計算が重くなる
+	if opt.Freq > rrule.DAILY {
+		return "", domain.NewInvalidArgumentError("recurrence", "tasks may repeat at most daily")
+	}
+	if _, err := rrule.NewRRule(*opt); err != nil {
+		return "", domain.NewInvalidArgumentError("recurrence", err.Error())
+	}
+	return opt.RRuleString(), nil
+}
+
+// nextOccurrence returns the first time of the series of t after its
+// limited_at. It returns false once the series has ended.
+func nextOccurrence(t *domain.Task) (time.Time, bool, error) {
+	opt, err := rrule.StrToROption(t.Recurrence)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	opt.Dtstart = *t.RecurrenceStart
+	rule, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	next := rule.After(t.LimitedAt, false)
+	return next, !next.IsZero(), nil
+}
+
+// createNextOccurrence creates the task that follows a recurring task that is
+// being completed by userID and returns its ID, or an empty string when there
+// is none.
+func (t taskRecorder) createNextOccurrence(ctx context.Context, tx *sql.Tx, current *domain.Task, userID string) (string, error) {
+	limitedAt, ok, err := nextOccurrence(current)
+	if err != nil || !ok {
+		return "", err
+	}
+	last, err := t.taskRepo.GetLastTaskPosition(ctx, tx, domain.TaskScopeParam{ProjectID: current.ProjectID, OwnerID: current.OwnerID})
+	if err != nil {
+		return "", err
+	}
+	position, err := domain.PositionBetween(last, "")
+	if err != nil {
+		return "", err
+	}
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+
+	created, err := t.taskRepo.CreateNextOccurrence(ctx, tx, domain.CreateNextOccurrenceParam{
+		ID:         uuid.String(),
+		PreviousID: current.ID,
+		LimitedAt:  limitedAt,
+		Position:   position,
+	})
+	if err != nil || !created {
+		return "", err
+	}
+	if err := t.taskTagRepo.CopyTaskTags(ctx, tx, domain.CopyTaskTagParam{FromTaskID: current.ID, ToTaskID: uuid.String()}); err != nil {
+		return "", err
+	}
+	tags, err := t.taskTagRepo.ListTagsByTaskIDs(ctx, domain.ListTaskTagParam{TaskIDs: []string{current.ID}})
+	if err != nil {
+		return "", err
+	}
+	next := *current
+	next.LimitedAt = limitedAt
+	changes := diffTaskFields(historyFields, nil, taskFieldValues(next, tagIDsOf(tags[current.ID])))
+	if err := t.recordHistory(ctx, tx, uuid.String(), userID, domain.TaskHistoryCreated, changes); err != nil {
+		return "", err
+	}
+	return uuid.String(), nil
+}
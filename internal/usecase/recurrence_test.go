@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRecurrence(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+	createTestTag(t, db, "recurrence_tag", "繰り返しテストタグ")
+
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	createRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:       "週次の掃除",
+		Description: "毎週月曜日",
+		LimitedAt:   timestamppb.New(start),
+		TagIds:      []string{"recurrence_tag"},
+		Recurrence:  "RRULE:FREQ=WEEKLY;COUNT=2",
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	var nextID string
+	t.Run("正常系_完了すると次の回が作られる", func(t *testing.T) {
+		res, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: createRes.Id, Status: "done"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.NextTaskId == "" {
+			t.Fatalf("expected next occurrence to be created")
+		}
+		nextID = res.NextTaskId
+
+		getRes, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: nextID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		next := getRes.Task
+		if !next.LimitedAt.AsTime().Equal(start.AddDate(0, 0, 7)) {
+			t.Errorf("expected limited_at a week later, got %v", next.LimitedAt.AsTime())
+		}
+		if next.Title != "週次の掃除" || next.Description != "毎週月曜日" || next.Status != "todo" || next.Recurrence != "FREQ=WEEKLY;COUNT=2" {
+			t.Errorf("expected copy of the task, got %v", next)
+		}
+		if len(next.Tags) != 1 || next.Tags[0].Id != "recurrence_tag" {
+			t.Errorf("expected tags to be copied, got %v", next.Tags)
+		}
+	})
+
+	t.Run("正常系_完了し直しても次の回は一つ", func(t *testing.T) {
+		if _, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: createRes.Id, Status: "todo"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		res, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: createRes.Id, Status: "done"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.NextTaskId != "" {
+			t.Errorf("expected no new occurrence, got %s", res.NextTaskId)
+		}
+	})
+
+	t.Run("正常系_回数を終えると作られない", func(t *testing.T) {
+		res, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: nextID, Status: "done"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.NextTaskId != "" {
+			t.Errorf("expected series to have ended, got %s", res.NextTaskId)
+		}
+	})
+
+	t.Run("正常系_UpdateTaskのis_endで完了しても次の回が作られる", func(t *testing.T) {
+		res, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:      "日次の点検",
+			LimitedAt:  timestamppb.New(start),
+			Recurrence: "RRULE:FREQ=DAILY",
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		// 同じリクエストで変えた期限から次の回を数える
+		updateRes, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         res.Id,
+			LimitedAt:  timestamppb.New(start.AddDate(0, 0, 3)),
+			IsEnd:      true,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"limited_at", "is_end"}},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updateRes.NextTaskId == "" {
+			t.Fatalf("expected next occurrence to be created")
+		}
+
+		getRes, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: res.Id})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !getRes.Task.IsEnd || getRes.Task.Status != "done" {
+			t.Errorf("expected task to be done, got %v", getRes.Task)
+		}
+		getRes, err = taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: updateRes.NextTaskId})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !getRes.Task.LimitedAt.AsTime().Equal(start.AddDate(0, 0, 4)) || getRes.Task.Status != "todo" {
+			t.Errorf("expected next occurrence a day after the new limited_at, got %v", getRes.Task)
+		}
+	})
+
+	t.Run("異常系_不正なルール", func(t *testing.T) {
+		for _, rule := range []string{"FREQ=SOMETIMES", "FREQ=HOURLY", "DTSTART:20250106T090000Z\nRRULE:FREQ=DAILY"} {
+			_, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+				Title:      "不正な繰り返し",
+				LimitedAt:  timestamppb.New(start),
+				Recurrence: rule,
+			})
+			if !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("expected invalid argument error for %q, got %v", rule, err)
+			}
+		}
+	})
+}
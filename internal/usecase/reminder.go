@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgres "github.com/sikigasa/task-controller/internal/infra/driver"
+)
+
+// 1回の実行で送るリマインダーの上限。残りは次の実行で送る
+const reminderBatchSize = 100
+
+// 送信に失敗したリマインダーを再送するまでの間隔。失敗するたびに倍にする
+const (
+	reminderRetryBackoff    = time.Minute
+	reminderMaxRetryBackoff = time.Hour
+)
+
+// ReminderScheduler periodically reminds the owners of tasks that are about to
+// reach their limited_at or are overdue.
+type ReminderScheduler struct {
+	reminderRepo infra.ReminderRepo
+	notifier     infra.Notifier
+	tx           postgres.Transaction
+	windows      []time.Duration
+}
+
+// NewReminderScheduler creates a scheduler that sends a reminder when a task
+// is due within each of windows. A window of zero reminds of overdue tasks.
+func NewReminderScheduler(reminderRepo infra.ReminderRepo, notifier infra.Notifier, tx postgres.Transaction, windows []time.Duration) *ReminderScheduler {
+	return &ReminderScheduler{
+		reminderRepo: reminderRepo,
+		notifier:     notifier,
+		tx:           tx,
+		windows:      windows,
+	}
+}
+
+// Run sends the due reminders every interval until ctx is done.
+func (s *ReminderScheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.SendDueReminders(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Printf("reminder: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDueReminders sends the reminders that are due at now and returns how
+// many were sent. Reminders that fail to be delivered are logged and tried
+// again after a backoff that grows with every failure.
+func (s *ReminderScheduler) SendDueReminders(ctx context.Context, now time.Time) (int, error) {
+	reminders, err := s.reminderRepo.ListDueReminder(ctx, domain.ListDueReminderParam{
+		Windows: s.windows,
+		Now:     now,
+		Limit:   reminderBatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		ok, err := s.send(ctx, reminder)
+		if err != nil {
+			if ctx.Err() != nil {
+				return sent, ctx.Err()
+			}
+			log.Printf("reminder for task %s: %v", reminder.TaskID, err)
+			if err := s.recordFailure(ctx, reminder, now, err); err != nil {
+				log.Printf("reminder for task %s: failed to record failure: %v", reminder.TaskID, err)
+			}
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// send records reminder and delivers it in the same transaction, so that it
+// is recorded only once delivered. It returns false when another replica has
+// already sent it.
+func (s *ReminderScheduler) send(ctx context.Context, reminder *domain.Reminder) (bool, error) {
+	var created bool
+	err := s.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		var err error
+		created, err = s.reminderRepo.CreateReminder(ctx, tx, domain.CreateReminderParam{
+			TaskID:    reminder.TaskID,
+			LimitedAt: reminder.LimitedAt,
+			Before:    reminder.Before,
+		})
+		if err != nil || !created {
+			return err
+		}
+		return s.notifier.Notify(ctx, reminder)
+	})
+	return created && err == nil, err
+}
+
+// recordFailure postpones the next attempt to send reminder.
+func (s *ReminderScheduler) recordFailure(ctx context.Context, reminder *domain.Reminder, now time.Time, cause error) error {
+	return s.reminderRepo.RecordReminderFailure(ctx, domain.RecordReminderFailureParam{
+		TaskID:     reminder.TaskID,
+		LimitedAt:  reminder.LimitedAt,
+		Before:     reminder.Before,
+		FailedAt:   now,
+		Error:      cause.Error(),
+		Backoff:    reminderRetryBackoff,
+		MaxBackoff: reminderMaxRetryBackoff,
+	})
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgresDriver "github.com/sikigasa/task-controller/internal/infra/driver"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recordingNotifier records the reminders it was asked to deliver, or fails
+// them while err is set.
+type recordingNotifier struct {
+	mu        sync.Mutex
+	reminders []domain.Reminder
+	err       error
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, reminder *domain.Reminder) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.err != nil {
+		return n.err
+	}
+	n.reminders = append(n.reminders, *reminder)
+	return nil
+}
+
+// take returns the windows of the recorded reminders by task and forgets them.
+func (n *recordingNotifier) take() map[string][]time.Duration {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	got := map[string][]time.Duration{}
+	for _, r := range n.reminders {
+		got[r.TaskID] = append(got[r.TaskID], r.Before)
+	}
+	n.reminders = nil
+	return got
+}
+
+func TestReminderScheduler(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+	notifier := &recordingNotifier{}
+	windows := []time.Duration{24 * time.Hour, time.Hour, 0}
+	newScheduler := func() *ReminderScheduler {
+		return NewReminderScheduler(infra.NewReminderRepo(db), notifier, postgresDriver.NewPostgresTransaction(db), windows)
+	}
+	scheduler := newScheduler()
+
+	now := time.Now()
+	createTask := func(t *testing.T, title string, limitedAt time.Time) string {
+		res, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:     title,
+			LimitedAt: timestamppb.New(limitedAt),
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		return res.Id
+	}
+	soonID := createTask(t, "30分後が期限", now.Add(30*time.Minute))
+	laterID := createTask(t, "3時間後が期限", now.Add(3*time.Hour))
+	createTask(t, "3日後が期限", now.Add(72*time.Hour))
+	overdueID := createTask(t, "期限切れ", now.Add(-time.Hour))
+	doneID := createTask(t, "完了済み", now.Add(-time.Hour))
+	if _, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: doneID, Status: "done"}); err != nil {
+		t.Fatalf("failed to complete task: %v", err)
+	}
+
+	expectSent := func(t *testing.T, at time.Time, want map[string][]time.Duration) {
+		t.Helper()
+		sent, err := scheduler.SendDueReminders(context.Background(), at)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		got := notifier.take()
+		if sent != len(want) || len(got) != len(want) {
+			t.Fatalf("expected %v, got %v (sent %d)", want, got, sent)
+		}
+		for id, w := range want {
+			if len(got[id]) != 1 || got[id][0] != w[0] {
+				t.Errorf("expected %v for task %s, got %v", w, id, got[id])
+			}
+		}
+	}
+
+	t.Run("正常系_最も近い期限のリマインダーだけを送る", func(t *testing.T) {
+		expectSent(t, now, map[string][]time.Duration{
+			soonID:    {time.Hour},
+			laterID:   {24 * time.Hour},
+			overdueID: {0},
+		})
+	})
+
+	t.Run("正常系_送信済みのリマインダーは送らない", func(t *testing.T) {
+		expectSent(t, now, nil)
+	})
+
+	t.Run("正常系_時間が経つと次のリマインダーを送る", func(t *testing.T) {
+		expectSent(t, now.Add(150*time.Minute), map[string][]time.Duration{
+			soonID:  {0},
+			laterID: {time.Hour},
+		})
+	})
+
+	t.Run("正常系_期限を変えると改めて送る", func(t *testing.T) {
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:        overdueID,
+			Title:     "期限切れ",
+			LimitedAt: timestamppb.New(now.Add(170 * time.Minute)),
+		})
+		if err != nil {
+			t.Fatalf("failed to update task: %v", err)
+		}
+		expectSent(t, now.Add(150*time.Minute), map[string][]time.Duration{
+			overdueID: {time.Hour},
+		})
+	})
+
+	t.Run("異常系_送信に失敗したリマインダーは間隔を空けて再送する", func(t *testing.T) {
+		failedID := createTask(t, "送信失敗", now.Add(200*time.Minute))
+		fail := func(t *testing.T, at time.Time) {
+			t.Helper()
+			notifier.err = errors.New("unavailable")
+			sent, err := scheduler.SendDueReminders(context.Background(), at)
+			notifier.err = nil
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if sent != 0 {
+				t.Fatalf("expected nothing to be sent, got %d", sent)
+			}
+		}
+
+		// 1回目の失敗の後は1分、2回目の失敗の後は2分待つ
+		fail(t, now.Add(150*time.Minute))
+		expectSent(t, now.Add(150*time.Minute), nil)
+		fail(t, now.Add(151*time.Minute))
+		expectSent(t, now.Add(152*time.Minute), nil)
+		expectSent(t, now.Add(153*time.Minute), map[string][]time.Duration{
+			failedID: {time.Hour},
+		})
+	})
+
+	t.Run("正常系_複数のレプリカでも一度だけ送る", func(t *testing.T) {
+		sharedID := createTask(t, "レプリカ", now.Add(160*time.Minute))
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		total := 0
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sent, err := newScheduler().SendDueReminders(context.Background(), now.Add(150*time.Minute))
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				mu.Lock()
+				total += sent
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+		got := notifier.take()
+		if total != 1 || len(got[sharedID]) != 1 {
+			t.Errorf("expected one reminder, got %v (sent %d)", got, total)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSubtask(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+
+	// parent > child > grandchild の3階層を作成する
+	parentID := createTestSubtask(t, taskService, "親タスク", "")
+	childID := createTestSubtask(t, taskService, "子タスク", parentID)
+	grandchildID := createTestSubtask(t, taskService, "孫タスク", childID)
+
+	t.Run("正常系_サブタスクの一覧", func(t *testing.T) {
+		res, err := taskService.ListSubtasks(testUserContext(), &task.ListSubtasksRequest{Id: parentID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.Tasks) != 1 || res.Tasks[0].Id != childID || res.Tasks[0].ParentId != parentID {
+			t.Errorf("expected child task, got %v", res.Tasks)
+		}
+	})
+
+	t.Run("異常系_存在しない親タスク", func(t *testing.T) {
+		_, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:     "迷子のタスク",
+			LimitedAt: timestamppb.Now(),
+			ParentId:  "00000000-0000-0000-0000-000000000000",
+		})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_サブタスクの下への移動", func(t *testing.T) {
+		for _, newParentID := range []string{parentID, grandchildID} {
+			_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+				Id:         parentID,
+				ParentId:   newParentID,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}},
+			})
+			if !errors.Is(err, domain.ErrFailedPrecondition) {
+				t.Errorf("expected failed precondition error for %s, got %v", newParentID, err)
+			}
+		}
+	})
+
+	t.Run("異常系_未完了のサブタスクがある", func(t *testing.T) {
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{
+			Id:     parentID,
+			Status: "done",
+		})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_進捗の集計", func(t *testing.T) {
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{
+			Id:     grandchildID,
+			Status: "done",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// 孫タスクのみ完了しているので、子孫2件のうち1件が完了
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: parentID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Task.Progress != 50 {
+			t.Errorf("expected progress 50, got %d", res.Task.Progress)
+		}
+		res, err = taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: grandchildID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Task.Progress != 100 {
+			t.Errorf("expected progress 100, got %d", res.Task.Progress)
+		}
+	})
+
+	t.Run("正常系_サブタスクもまとめて完了", func(t *testing.T) {
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{
+			Id:                parentID,
+			Status:            "done",
+			SubtaskCompletion: task.TransitionTaskRequest_SUBTASK_COMPLETION_CASCADE,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: childID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !res.Task.IsEnd || res.Task.Status != "done" {
+			t.Errorf("expected child task to be done, got %v", res.Task.Status)
+		}
+	})
+
+	t.Run("正常系_親タスクの付け替え", func(t *testing.T) {
+		otherID := createTestSubtask(t, taskService, "別の親タスク", "")
+		_, err := taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         grandchildID,
+			ParentId:   otherID,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		res, err := taskService.ListSubtasks(testUserContext(), &task.ListSubtasksRequest{Id: childID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.Tasks) != 0 {
+			t.Errorf("expected no subtasks, got %v", res.Tasks)
+		}
+	})
+
+	t.Run("正常系_親タスクの削除", func(t *testing.T) {
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: parentID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		// サブタスクも削除される
+		if _, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: childID}); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func createTestSubtask(t *testing.T, taskService v1connect.TaskServiceHandler, title, parentID string) string {
+	res, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:     title,
+		LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		ParentId:  parentID,
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	return res.Id
+}
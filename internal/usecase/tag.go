@@ -7,27 +7,41 @@ import (
 	"github.com/sikigasa/task-controller/internal/domain"
 	"github.com/sikigasa/task-controller/internal/infra"
 	tag "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
 )
 
 type TagService struct {
-	tag.UnimplementedTagServiceServer
-	tagRepo infra.TagRepo
+	v1connect.UnimplementedTagServiceHandler
+	tagRepo     infra.TagRepo
+	projectRepo infra.ProjectRepo
 }
 
-func NewTagService(tagRepo infra.TagRepo) tag.TagServiceServer {
+func NewTagService(tagRepo infra.TagRepo, projectRepo infra.ProjectRepo) v1connect.TagServiceHandler {
 	return &TagService{
-		tagRepo: tagRepo,
+		tagRepo:     tagRepo,
+		projectRepo: projectRepo,
 	}
 }
 
 func (t *TagService) CreateTag(ctx context.Context, req *tag.CreateTagRequest) (*tag.CreateTagResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.ProjectId != "" {
+		if err := authorizeProjectReference(ctx, t.projectRepo, req.ProjectId, userID, "tag"); err != nil {
+			return nil, err
+		}
+	}
 	uuid, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
 	}
 	param := domain.CreateTagParam{
-		ID:   uuid.String(),
-		Name: req.Name,
+		ID:        uuid.String(),
+		OwnerID:   userID,
+		ProjectID: req.ProjectId,
+		Name:      req.Name,
 	}
 
 	if err := t.tagRepo.CreateTag(ctx, param); err != nil {
@@ -39,33 +53,117 @@ func (t *TagService) CreateTag(ctx context.Context, req *tag.CreateTagRequest) (
 	}, nil
 }
 
+func (t *TagService) GetTag(ctx context.Context, req *tag.GetTagRequest) (*tag.GetTagResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	param := domain.GetTagParam{
+		ID:     req.Id,
+		UserID: userID,
+	}
+
+	result, err := t.tagRepo.GetTag(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tag.GetTagResponse{
+		Tag: &tag.Tag{
+			Id:        result.ID,
+			Name:      result.Name,
+			ProjectId: result.ProjectID,
+		},
+	}, nil
+}
+
 func (t *TagService) ListTag(ctx context.Context, req *tag.ListTagRequest) (*tag.ListTagResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit == 0 {
+		req.Limit = 100
+	}
+	token, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	// 次のページの有無を判定するために1件多く取得する
 	param := domain.ListTagParam{
-		Limit:  req.Limit,
-		Offset: req.Offset,
+		UserID:  userID,
+		Limit:   req.Limit + 1,
+		Offset:  req.Offset,
+		AfterID: token.LastID,
+	}
+	if token.LastID != "" {
+		param.Offset = 0
 	}
 
 	tags, err := t.tagRepo.ListTag(ctx, param)
 	if err != nil {
 		return nil, err
 	}
+	var nextPageToken string
+	if len(tags) > int(req.Limit) {
+		tags = tags[:req.Limit]
+		nextPageToken = encodePageToken(pageToken{LastID: tags[len(tags)-1].ID})
+	}
+	totalSize, err := t.tagRepo.CountTag(ctx, param)
+	if err != nil {
+		return nil, err
+	}
 
 	var tagList []*tag.Tag
 	for _, t := range tags {
 		tagList = append(tagList, &tag.Tag{
-			Id:   t.ID,
-			Name: t.Name,
+			Id:        t.ID,
+			Name:      t.Name,
+			ProjectId: t.ProjectID,
 		})
 	}
 
 	return &tag.ListTagResponse{
-		Tags: tagList,
+		Tags:          tagList,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+func (t *TagService) UpdateTag(ctx context.Context, req *tag.UpdateTagRequest) (*tag.UpdateTagResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.authorizeTagWrite(ctx, req.Id, userID); err != nil {
+		return nil, err
+	}
+	param := domain.UpdateTagParam{
+		ID:     req.Id,
+		UserID: userID,
+		Name:   req.Name,
+	}
+
+	if err := t.tagRepo.UpdateTag(ctx, param); err != nil {
+		return nil, err
+	}
+
+	return &tag.UpdateTagResponse{
+		Success: true,
 	}, nil
 }
 
 func (t *TagService) DeleteTag(ctx context.Context, req *tag.DeleteTagRequest) (*tag.DeleteTagResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.authorizeTagWrite(ctx, req.Id, userID); err != nil {
+		return nil, err
+	}
 	param := domain.DeleteTagParam{
-		ID: req.Id,
+		ID:     req.Id,
+		UserID: userID,
 	}
 
 	if err := t.tagRepo.DeleteTag(ctx, param); err != nil {
@@ -76,3 +174,17 @@ func (t *TagService) DeleteTag(ctx context.Context, req *tag.DeleteTagRequest) (
 		Success: true,
 	}, nil
 }
+
+// authorizeTagWrite reports an error unless userID may change the tag.
+// Project tags need the editor role.
+func (t *TagService) authorizeTagWrite(ctx context.Context, tagID, userID string) error {
+	result, err := t.tagRepo.GetTag(ctx, domain.GetTagParam{ID: tagID, UserID: userID})
+	if err != nil {
+		return err
+	}
+	if result.ProjectID == "" {
+		return nil
+	}
+	_, err = authorizeProject(ctx, t.projectRepo, result.ProjectID, userID, domain.ProjectRoleEditor)
+	return err
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	tag "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
+)
+
+func TestTag(t *testing.T) {
+	db, _, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	tagService := NewTagService(infra.NewTagRepo(db), infra.NewProjectRepo(db))
+
+	t.Run("CreateTag", func(t *testing.T) {
+		testCreateTag(t, tagService)
+	})
+
+	t.Run("GetTag", func(t *testing.T) {
+		testGetTag(t, tagService)
+	})
+
+	t.Run("UpdateTag", func(t *testing.T) {
+		testUpdateTag(t, tagService)
+	})
+}
+
+func testCreateTag(t *testing.T, tagService v1connect.TagServiceHandler) {
+	t.Run("異常系_大文字小文字違いの重複", func(t *testing.T) {
+		if _, err := tagService.CreateTag(testUserContext(), &tag.CreateTagRequest{Name: "Home"}); err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+
+		_, err := tagService.CreateTag(testUserContext(), &tag.CreateTagRequest{Name: "HOME"})
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("expected already exists error, got %v", err)
+		}
+	})
+}
+
+func testGetTag(t *testing.T, tagService v1connect.TagServiceHandler) {
+	t.Run("正常系", func(t *testing.T) {
+		createRes, err := tagService.CreateTag(testUserContext(), &tag.CreateTagRequest{Name: "取得タグ"})
+		if err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+
+		res, err := tagService.GetTag(testUserContext(), &tag.GetTagRequest{Id: createRes.Id})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Tag.Id != createRes.Id || res.Tag.Name != "取得タグ" {
+			t.Errorf("expected tag %s '取得タグ', got %v", createRes.Id, res.Tag)
+		}
+	})
+
+	t.Run("異常系_存在しないタグ", func(t *testing.T) {
+		_, err := tagService.GetTag(testUserContext(), &tag.GetTagRequest{Id: "non-existent-id"})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func testUpdateTag(t *testing.T, tagService v1connect.TagServiceHandler) {
+	t.Run("正常系", func(t *testing.T) {
+		createRes, err := tagService.CreateTag(testUserContext(), &tag.CreateTagRequest{Name: "変更前タグ"})
+		if err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+
+		res, err := tagService.UpdateTag(testUserContext(), &tag.UpdateTagRequest{Id: createRes.Id, Name: "変更後タグ"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !res.Success {
+			t.Errorf("expected successful update")
+		}
+
+		getRes, err := tagService.GetTag(testUserContext(), &tag.GetTagRequest{Id: createRes.Id})
+		if err != nil {
+			t.Fatalf("failed to get tag: %v", err)
+		}
+		if getRes.Tag.Name != "変更後タグ" {
+			t.Errorf("expected name '変更後タグ', got %v", getRes.Tag.Name)
+		}
+	})
+
+	t.Run("異常系_大文字小文字違いの重複", func(t *testing.T) {
+		if _, err := tagService.CreateTag(testUserContext(), &tag.CreateTagRequest{Name: "Work"}); err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+		createRes, err := tagService.CreateTag(testUserContext(), &tag.CreateTagRequest{Name: "Private"})
+		if err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+
+		_, err = tagService.UpdateTag(testUserContext(), &tag.UpdateTagRequest{Id: createRes.Id, Name: "work"})
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("expected already exists error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_存在しないタグ", func(t *testing.T) {
+		_, err := tagService.UpdateTag(testUserContext(), &tag.UpdateTagRequest{Id: "non-existent-id", Name: "存在しないタグ"})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
@@ -3,50 +3,123 @@ package usecase
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/sikigasa/task-controller/internal/domain"
 	"github.com/sikigasa/task-controller/internal/infra"
 	postgres "github.com/sikigasa/task-controller/internal/infra/driver"
 	task "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type taskService struct {
-	task.UnimplementedTaskServiceServer
-	taskRepo    infra.TaskRepo
-	tagRepo     infra.TagRepo
-	taskTagRepo infra.TaskTagRepo
-	tx          postgres.Transaction
+	v1connect.UnimplementedTaskServiceHandler
+	taskRecorder
+	tagRepo        infra.TagRepo
+	projectRepo    infra.ProjectRepo
+	dependencyRepo infra.TaskDependencyRepo
+	workflowRepo   infra.WorkflowRepo
+	taskWatcher    infra.TaskWatcher
+	tx             postgres.Transaction
 }
 
-func NewTaskService(taskRepo infra.TaskRepo, tagRepo infra.TagRepo, taskTagRepo infra.TaskTagRepo, tx postgres.Transaction) task.TaskServiceServer {
+func NewTaskService(taskRepo infra.TaskRepo, tagRepo infra.TagRepo, taskTagRepo infra.TaskTagRepo, projectRepo infra.ProjectRepo, dependencyRepo infra.TaskDependencyRepo, workflowRepo infra.WorkflowRepo, historyRepo infra.TaskHistoryRepo, taskWatcher infra.TaskWatcher, tx postgres.Transaction) v1connect.TaskServiceHandler {
 	return &taskService{
-		taskRepo:    taskRepo,
-		tagRepo:     tagRepo,
-		taskTagRepo: taskTagRepo,
-		tx:          tx,
+		taskRecorder:   newTaskRecorder(taskRepo, taskTagRepo, historyRepo),
+		tagRepo:        tagRepo,
+		projectRepo:    projectRepo,
+		dependencyRepo: dependencyRepo,
+		workflowRepo:   workflowRepo,
+		taskWatcher:    taskWatcher,
+		tx:             tx,
 	}
 }
 
 func (t *taskService) CreateTask(ctx context.Context, req *task.CreateTaskRequest) (*task.CreateTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// サブタスクは親タスクと同じプロジェクトに属する
+	projectID := req.ProjectId
+	if req.ParentId != "" {
+		parent, err := t.getParentTask(ctx, req.ParentId, userID)
+		if err != nil {
+			return nil, err
+		}
+		if projectID == "" {
+			projectID = parent.ProjectID
+		}
+		if parent.ProjectID != projectID {
+			return nil, parentProjectMismatchError(req.ParentId)
+		}
+	}
+	if projectID != "" {
+		if err := authorizeProjectReference(ctx, t.projectRepo, projectID, userID, "task"); err != nil {
+			return nil, err
+		}
+	}
+	var recurrenceStart *time.Time
+	if req.Recurrence != "" {
+		if req.Recurrence, err = parseRecurrence(req.Recurrence); err != nil {
+			return nil, err
+		}
+		limitedAt := req.LimitedAt.AsTime()
+		recurrenceStart = &limitedAt
+	}
 	uuid, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
 	}
 
 	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		// 新しいタスクは並び順の末尾に置く
+		last, err := t.taskRepo.GetLastTaskPosition(ctx, tx, domain.TaskScopeParam{ProjectID: projectID, OwnerID: userID})
+		if err != nil {
+			return err
+		}
+		position, err := domain.PositionBetween(last, "")
+		if err != nil {
+			return err
+		}
 		param := domain.CreateTaskParam{
 			ID:          uuid.String(),
+			OwnerID:     userID,
+			ProjectID:   projectID,
+			ParentID:    req.ParentId,
 			Title:       req.Title,
 			Description: req.Description,
 			LimitedAt:   req.LimitedAt.AsTime(),
-			IsEnd:       false,
+			Priority:    toTaskPriority(req.Priority),
+			Position:    position,
+
+			Recurrence:      req.Recurrence,
+			RecurrenceStart: recurrenceStart,
 		}
 
 		if err := t.taskRepo.CreateTask(ctx, tx, param); err != nil {
 			return err
 		}
+		created := domain.Task{
+			Title:       param.Title,
+			Description: param.Description,
+			LimitedAt:   param.LimitedAt,
+			ParentID:    param.ParentID,
+			Priority:    param.Priority,
+			Recurrence:  param.Recurrence,
+		}
+		changes := diffTaskFields(historyFields, nil, taskFieldValues(created, req.TagIds))
+		if err := t.recordHistory(ctx, tx, param.ID, userID, domain.TaskHistoryCreated, changes); err != nil {
+			return err
+		}
 
 		if len(req.TagIds) == 0 || req.TagIds[0] == "" {
 			return nil
@@ -55,6 +128,7 @@ func (t *taskService) CreateTask(ctx context.Context, req *task.CreateTaskReques
 			taskTagParam := domain.CreateTaskTagParam{
 				TaskID: param.ID,
 				TagID:  tagID,
+				UserID: userID,
 			}
 			if err := t.taskTagRepo.CreateTaskTag(ctx, tx, taskTagParam); err != nil {
 				return err
@@ -73,8 +147,13 @@ func (t *taskService) CreateTask(ctx context.Context, req *task.CreateTaskReques
 }
 
 func (t *taskService) GetTask(ctx context.Context, req *task.GetTaskRequest) (*task.GetTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	param := domain.GetTaskParam{
-		ID: req.Id,
+		ID:     req.Id,
+		UserID: userID,
 	}
 
 	taskDetail, err := t.taskRepo.GetTask(ctx, param)
@@ -82,135 +161,255 @@ func (t *taskService) GetTask(ctx context.Context, req *task.GetTaskRequest) (*t
 		return nil, err
 	}
 
-	taskTagIDs, err := t.taskTagRepo.GetTaskTagIDs(ctx, domain.GetTaskTagParam{TaskID: taskDetail.ID})
+	tags, err := t.taskTagRepo.ListTagsByTaskIDs(ctx, domain.ListTaskTagParam{TaskIDs: []string{taskDetail.ID}})
 	if err != nil {
 		return nil, err
 	}
-	var protoTags []*task.Tag
-	for _, tagID := range taskTagIDs {
-		tag, err := t.tagRepo.GetTag(ctx, domain.GetTagParam{ID: tagID.TagID})
-		if err != nil {
-			return nil, err
-		}
-		protoTags = append(protoTags, &task.Tag{
-			Id:   tag.ID,
-			Name: tag.Name,
-		})
+	progress, err := t.taskRepo.ListTaskProgress(ctx, domain.ListTaskProgressParam{TaskIDs: []string{taskDetail.ID}})
+	if err != nil {
+		return nil, err
 	}
 
 	return &task.GetTaskResponse{
-		Task: &task.Task{
-			Id:          taskDetail.ID,
-			Title:       taskDetail.Title,
-			Description: taskDetail.Description,
-			CreatedAt:   timestamppb.New(taskDetail.CreatedAt),
-			UpdatedAt:   timestamppb.New(taskDetail.UpdateAt),
-			LimitedAt:   timestamppb.New(taskDetail.LimitedAt),
-			IsEnd:       taskDetail.IsEnd,
-			Tags:        protoTags,
-		},
+		Task: toProtoTask(*taskDetail, tags[taskDetail.ID], progress[taskDetail.ID]),
 	}, nil
 }
 
 func (t *taskService) ListTask(ctx context.Context, req *task.ListTaskRequest) (*task.ListTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if req.Limit == 0 {
 		req.Limit = 10
 	}
+	orderBy, desc, err := parseTaskOrder(req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	token, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	if token.LastID != "" && token.OrderBy != req.OrderBy {
+		return nil, domain.NewInvalidArgumentError("page_token", "page token does not match order_by")
+	}
+	// 次のページの有無を判定するために1件多く取得する
 	param := domain.ListTaskParam{
-		Limit:  req.Limit,
-		Offset: req.Offset,
+		UserID:     userID,
+		Limit:      req.Limit + 1,
+		Offset:     req.Offset,
+		AfterID:    token.LastID,
+		AfterValue: token.LastValue,
+		Filter:     toTaskFilter(req.Filter),
+		OrderBy:    orderBy,
+		Desc:       desc,
+	}
+	if token.LastID != "" {
+		param.Offset = 0
 	}
 
 	tasks, err := t.taskRepo.ListTask(ctx, param)
 	if err != nil {
 		return nil, err
 	}
+	var nextPageToken string
+	if len(tasks) > int(req.Limit) {
+		tasks = tasks[:req.Limit]
+		last := tasks[len(tasks)-1]
+		nextPageToken = encodePageToken(pageToken{
+			LastID:    last.ID,
+			LastValue: taskOrderValue(last, orderBy),
+			OrderBy:   req.OrderBy,
+		})
+	}
+	totalSize, err := t.taskRepo.CountTask(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	// タスクのタグはページ単位でまとめて取得する
+	taskIDs := make([]string, 0, len(tasks))
+	for _, taskDetail := range tasks {
+		taskIDs = append(taskIDs, taskDetail.ID)
+	}
+	tags, err := t.taskTagRepo.ListTagsByTaskIDs(ctx, domain.ListTaskTagParam{TaskIDs: taskIDs})
+	if err != nil {
+		return nil, err
+	}
+	progress, err := t.taskRepo.ListTaskProgress(ctx, domain.ListTaskProgressParam{TaskIDs: taskIDs})
+	if err != nil {
+		return nil, err
+	}
 
 	var taskList []*task.Task
 	for _, taskDetail := range tasks {
-		taskTagIDs, err := t.taskTagRepo.GetTaskTagIDs(ctx, domain.GetTaskTagParam{TaskID: taskDetail.ID})
-		if err != nil {
-			return nil, err
-		}
-		var protoTags []*task.Tag
-		for _, tagID := range taskTagIDs {
-			tag, err := t.tagRepo.GetTag(ctx, domain.GetTagParam{ID: tagID.TagID})
-			if err != nil {
-				return nil, err
-			}
-			protoTags = append(protoTags, &task.Tag{
-				Id:   tag.ID,
-				Name: tag.Name,
-			})
-		}
-
-		taskList = append(taskList, &task.Task{
-			Id:          taskDetail.ID,
-			Title:       taskDetail.Title,
-			Description: taskDetail.Description,
-			CreatedAt:   timestamppb.New(taskDetail.CreatedAt),
-			UpdatedAt:   timestamppb.New(taskDetail.UpdateAt),
-			LimitedAt:   timestamppb.New(taskDetail.LimitedAt),
-			IsEnd:       taskDetail.IsEnd,
-			Tags:        protoTags,
-		})
+		taskList = append(taskList, toProtoTask(taskDetail, tags[taskDetail.ID], progress[taskDetail.ID]))
 	}
 
 	return &task.ListTaskResponse{
-		Tasks: taskList,
+		Tasks:         taskList,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
 	}, nil
 }
 
 func (t *taskService) UpdateTask(ctx context.Context, req *task.UpdateTaskRequest) (*task.UpdateTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if req.TagIds == nil {
 		req.TagIds = []string{}
 	}
-	err := t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+
+	// update_maskが指定されていない場合は全フィールドを更新する
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{domain.TaskFieldTitle, domain.TaskFieldDescription, domain.TaskFieldLimitedAt, domain.TaskFieldIsEnd, domain.TaskFieldTagIDs}
+		// 後から追加したフィールドを知らないクライアントが消してしまわないよう、指定されたときだけ更新する
+		if req.Priority != task.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+			paths = append(paths, domain.TaskFieldPriority)
+		}
+		if req.Recurrence != "" {
+			paths = append(paths, domain.TaskFieldRecurrence)
+		}
+	}
+	updateTags := slices.Contains(paths, domain.TaskFieldTagIDs)
+	version, err := parseETag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	current, err := t.authorizeTaskWrite(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkETag(req.Id, version, current.Version); err != nil {
+		return nil, err
+	}
+	if slices.Contains(paths, domain.TaskFieldParentID) && req.ParentId != "" {
+		parent, err := t.getParentTask(ctx, req.ParentId, userID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ProjectID != current.ProjectID {
+			return nil, parentProjectMismatchError(req.ParentId)
+		}
+	}
+	// 繰り返しを設定し直すとlimited_atから数え直す
+	var recurrenceStart *time.Time
+	if slices.Contains(paths, domain.TaskFieldRecurrence) && req.Recurrence != "" {
+		if req.Recurrence, err = parseRecurrence(req.Recurrence); err != nil {
+			return nil, err
+		}
+		start := current.LimitedAt
+		if slices.Contains(paths, domain.TaskFieldLimitedAt) {
+			start = req.LimitedAt.AsTime()
+		}
+		recurrenceStart = &start
+	}
+	// is_endはTransitionTaskと同じようにワークフローのステータスを移動させる
+	transition := slices.Contains(paths, domain.TaskFieldIsEnd) && req.IsEnd != current.IsEnd
+	currentTags, err := t.taskTagRepo.ListTagsByTaskIDs(ctx, domain.ListTaskTagParam{TaskIDs: []string{req.Id}})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextTaskID string
+	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
 		param := domain.UpdateTaskParam{
 			ID:          req.Id,
+			UserID:      userID,
 			Title:       req.Title,
 			Description: req.Description,
 			LimitedAt:   req.LimitedAt.AsTime(),
-			IsEnd:       req.IsEnd,
+			Priority:    toTaskPriority(req.Priority),
+			ParentID:    req.ParentId,
+			UpdateMask:  paths,
+			// 確認した後に他のリクエストが更新した場合もConflictになる
+			Version: version,
+
+			Recurrence:      req.Recurrence,
+			RecurrenceStart: recurrenceStart,
+		}
+		if slices.Contains(paths, domain.TaskFieldParentID) && req.ParentId != "" {
+			// 自分自身やサブタスクの下には移動できない
+			cycle, err := t.taskRepo.IsTaskDescendant(ctx, tx, domain.IsTaskDescendantParam{TaskID: req.ParentId, AncestorID: req.Id})
+			if err != nil {
+				return err
+			}
+			if cycle {
+				e := domain.NewFailedPreconditionError("PARENT_CYCLE", "a task cannot be moved below itself or its subtasks")
+				e.Resource = "task"
+				e.Field = "parent_id"
+				return e
+			}
 		}
 		if err := t.taskRepo.UpdateTask(ctx, tx, param); err != nil {
 			return err
 		}
-		if err := t.taskTagRepo.DeleteTaskTags(ctx, tx, domain.DeleteTaskTagParam{TaskID: req.Id}); err != nil {
+		if err := t.recordHistory(ctx, tx, req.Id, userID, domain.TaskHistoryUpdated, updateChanges(current, tagIDsOf(currentTags[req.Id]), param, req.TagIds)); err != nil {
 			return err
 		}
-
-		if len(req.TagIds) == 0 || req.TagIds[0] == "" {
-			return nil
-		}
-		for _, tagID := range req.TagIds {
-			taskTagParam := domain.CreateTaskTagParam{
-				TaskID: param.ID,
-				TagID:  tagID,
-			}
-			if err := t.taskTagRepo.CreateTaskTag(ctx, tx, taskTagParam); err != nil {
+		if updateTags {
+			if err := t.replaceTaskTags(ctx, tx, req.Id, userID, req.TagIds); err != nil {
 				return err
 			}
 		}
-		return nil
+		if !transition {
+			return nil
+		}
+		status, err := t.statusForIsEnd(ctx, tx, current, req.IsEnd)
+		if err != nil {
+			return err
+		}
+		// 次の回は更新後のlimited_atと繰り返しから作る
+		updated := applyUpdate(current, param)
+		nextTaskID, err = t.transitionTask(ctx, tx, &updated, userID, status, req.SubtaskCompletion, req.Force)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &task.UpdateTaskResponse{
-		Success: true,
+		Success:    true,
+		NextTaskId: nextTaskID,
 	}, nil
 }
 
 func (t *taskService) DeleteTask(ctx context.Context, req *task.DeleteTaskRequest) (*task.DeleteTaskResponse, error) {
-	err := t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
-		if err := t.taskTagRepo.DeleteTaskTags(ctx, tx, domain.DeleteTaskTagParam{TaskID: req.Id}); err != nil {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := parseETag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	current, err := t.authorizeTaskWrite(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkETag(req.Id, version, current.Version); err != nil {
+		return nil, err
+	}
+	currentTags, err := t.taskTagRepo.ListTagsByTaskIDs(ctx, domain.ListTaskTagParam{TaskIDs: []string{req.Id}})
+	if err != nil {
+		return nil, err
+	}
+	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		// 削除時の値を残しておく
+		changes := diffTaskFields(historyFields, taskFieldValues(*current, tagIDsOf(currentTags[req.Id])), nil)
+		if err := t.recordHistory(ctx, tx, req.Id, userID, domain.TaskHistoryDeleted, changes); err != nil {
 			return err
 		}
 
+		// タグは復元に備えて残しておく
 		param := domain.DeleteTaskParam{
-			ID: req.Id,
+			ID:      req.Id,
+			UserID:  userID,
+			Version: version,
 		}
 		if err := t.taskRepo.DeleteTask(ctx, tx, param); err != nil {
 			return err
@@ -226,3 +425,639 @@ func (t *taskService) DeleteTask(ctx context.Context, req *task.DeleteTaskReques
 		Success: true,
 	}, nil
 }
+
+func (t *taskService) ListSubtasks(ctx context.Context, req *task.ListSubtasksRequest) (*task.ListSubtasksResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := t.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: req.Id, UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	listRes, err := t.ListTask(ctx, &task.ListTaskRequest{
+		Limit:     req.Limit,
+		PageToken: req.PageToken,
+		Filter:    &task.TaskFilter{ParentId: req.Id},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListSubtasksResponse{
+		Tasks:         listRes.Tasks,
+		NextPageToken: listRes.NextPageToken,
+		TotalSize:     listRes.TotalSize,
+	}, nil
+}
+
+func (t *taskService) TransitionTask(ctx context.Context, req *task.TransitionTaskRequest) (*task.TransitionTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := t.authorizeTaskWrite(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	status, err := t.workflowRepo.GetWorkflowStatus(ctx, domain.GetWorkflowStatusParam{ProjectID: current.ProjectID, Name: req.Status})
+	if errors.Is(err, domain.ErrNotFound) {
+		e := domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", "status "+req.Status+" is not part of the workflow")
+		e.Resource = "task"
+		e.Field = "status"
+		return nil, e
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var nextTaskID string
+	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		nextTaskID, err = t.transitionTask(ctx, tx, current, userID, status, req.SubtaskCompletion, req.Force)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.TransitionTaskResponse{
+		Success:    true,
+		NextTaskId: nextTaskID,
+	}, nil
+}
+
+func (t *taskService) MoveTask(ctx context.Context, req *task.MoveTaskRequest) (*task.MoveTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// バリデーションを通らずに呼ばれても隣のタスクがない状態で位置を計算しない
+	if req.AfterId == "" && req.BeforeId == "" {
+		return nil, domain.NewInvalidArgumentError("after_id", "after_id or before_id is required")
+	}
+	current, err := t.authorizeTaskWrite(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	after, err := t.getNeighborTask(ctx, current, req.AfterId, userID, "after_id")
+	if err != nil {
+		return nil, err
+	}
+	before, err := t.getNeighborTask(ctx, current, req.BeforeId, userID, "before_id")
+	if err != nil {
+		return nil, err
+	}
+
+	var position string
+	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		// 片方の隣だけが指定された場合は、そのすぐ隣に置く
+		scope := domain.TaskScopeParam{ProjectID: current.ProjectID, OwnerID: current.OwnerID}
+		var lower, upper string
+		if after != nil {
+			lower = after.Position
+		} else {
+			lower, err = t.taskRepo.GetNeighborPosition(ctx, tx, domain.GetNeighborPositionParam{Scope: scope, Position: before.Position, Before: true, ExcludeID: req.Id})
+			if err != nil {
+				return err
+			}
+		}
+		if before != nil {
+			upper = before.Position
+		} else {
+			upper, err = t.taskRepo.GetNeighborPosition(ctx, tx, domain.GetNeighborPositionParam{Scope: scope, Position: after.Position, ExcludeID: req.Id})
+			if err != nil {
+				return err
+			}
+		}
+
+		position, err = domain.PositionBetween(lower, upper)
+		if errors.Is(err, domain.ErrPositionOrder) {
+			e := domain.NewFailedPreconditionError("NEIGHBORS_OUT_OF_ORDER", "after_id must come before before_id")
+			e.Resource = "task"
+			e.Field = "before_id"
+			return e
+		}
+		if err != nil {
+			return err
+		}
+		if err := t.taskRepo.MoveTask(ctx, tx, domain.MoveTaskParam{ID: req.Id, Position: position}); err != nil {
+			return err
+		}
+		return t.recordHistory(ctx, tx, req.Id, userID, domain.TaskHistoryUpdated, []domain.FieldChange{
+			{Field: domain.TaskFieldPosition, Before: current.Position, After: position},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.MoveTaskResponse{
+		Position: position,
+	}, nil
+}
+
+func (t *taskService) AddDependency(ctx context.Context, req *task.AddDependencyRequest) (*task.AddDependencyResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := t.authorizeTaskWrite(ctx, req.TaskId, userID); err != nil {
+		return nil, err
+	}
+	// 見えないタスクには依存させない
+	if _, err := t.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: req.BlockedById, UserID: userID}); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			e := domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", "blocking task "+req.BlockedById+" not found")
+			e.Resource = "task_dependency"
+			e.Field = "blocked_by_id"
+			return nil, e
+		}
+		return nil, err
+	}
+
+	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if err := t.dependencyRepo.LockTaskDependency(ctx, tx); err != nil {
+			return err
+		}
+		// 依存先がすでにこのタスクを待っていれば循環する
+		cycle, err := t.dependencyRepo.IsTaskBlockedBy(ctx, tx, domain.IsTaskBlockedByParam{TaskID: req.BlockedById, BlockedByID: req.TaskId})
+		if err != nil {
+			return err
+		}
+		if cycle || req.BlockedById == req.TaskId {
+			e := domain.NewFailedPreconditionError("DEPENDENCY_CYCLE", "a task cannot be blocked by itself through its dependencies")
+			e.Resource = "task_dependency"
+			e.Field = "blocked_by_id"
+			return e
+		}
+		err = t.dependencyRepo.CreateTaskDependency(ctx, tx, domain.CreateTaskDependencyParam{
+			TaskID:      req.TaskId,
+			BlockedByID: req.BlockedById,
+		})
+		if err != nil {
+			return err
+		}
+		return t.recordHistory(ctx, tx, req.TaskId, userID, domain.TaskHistoryUpdated, []domain.FieldChange{
+			{Field: domain.TaskFieldBlockedByID, After: req.BlockedById},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.AddDependencyResponse{
+		Success: true,
+	}, nil
+}
+
+func (t *taskService) RemoveDependency(ctx context.Context, req *task.RemoveDependencyRequest) (*task.RemoveDependencyResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := t.authorizeTaskWrite(ctx, req.TaskId, userID); err != nil {
+		return nil, err
+	}
+	param := domain.DeleteTaskDependencyParam{
+		TaskID:      req.TaskId,
+		BlockedByID: req.BlockedById,
+	}
+
+	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if err := t.dependencyRepo.DeleteTaskDependency(ctx, tx, param); err != nil {
+			return err
+		}
+		return t.recordHistory(ctx, tx, req.TaskId, userID, domain.TaskHistoryUpdated, []domain.FieldChange{
+			{Field: domain.TaskFieldBlockedByID, Before: req.BlockedById},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.RemoveDependencyResponse{
+		Success: true,
+	}, nil
+}
+
+func (t *taskService) WatchTasks(ctx context.Context, req *task.WatchTasksRequest, stream *connect.ServerStream[task.WatchTasksResponse]) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	events := t.taskWatcher.WatchTask(ctx)
+	for {
+		event, ok := <-events
+		if !ok {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return domain.NewUnavailableError("WATCH_INTERRUPTED", "task events may have been missed, list tasks and watch again")
+		}
+		// プロジェクトのタスクはメンバーかどうかで判定する
+		if event.ProjectID == "" && event.OwnerID != userID {
+			continue
+		}
+
+		res := &task.WatchTasksResponse{
+			Type: toProtoEventType(event.Type),
+			Task: &task.Task{Id: event.TaskID},
+		}
+		if event.Type == domain.TaskEventDeleted && event.ProjectID != "" {
+			_, err := t.projectRepo.GetProject(ctx, domain.GetProjectParam{ID: event.ProjectID, UserID: userID})
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if event.Type != domain.TaskEventDeleted {
+			getRes, err := t.GetTask(ctx, &task.GetTaskRequest{Id: event.TaskID})
+			// 取得前に削除されていれば、後から届く削除イベントに任せる
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			res.Task = getRes.Task
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+// authorizeTaskWrite returns the task if userID may change it. Personal tasks
+// can only be seen by their owner, while project tasks need the editor role.
+func (t *taskService) authorizeTaskWrite(ctx context.Context, taskID, userID string) (*domain.Task, error) {
+	return authorizeTaskWrite(ctx, t.taskRepo, t.projectRepo, taskID, userID)
+}
+
+// getParentTask returns the task to put a subtask below.
+func (t *taskService) getParentTask(ctx context.Context, parentID, userID string) (*domain.Task, error) {
+	parent, err := t.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: parentID, UserID: userID})
+	if errors.Is(err, domain.ErrNotFound) {
+		e := domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", "parent task "+parentID+" not found")
+		e.Resource = "task"
+		e.Field = "parent_id"
+		return nil, e
+	}
+	return parent, err
+}
+
+// getNeighborTask returns the task to place a moved task next to, or nil when
+// neighborID is empty. Neighbors must be ordered together with the moved task.
+func (t *taskService) getNeighborTask(ctx context.Context, current *domain.Task, neighborID, userID, field string) (*domain.Task, error) {
+	if neighborID == "" {
+		return nil, nil
+	}
+	neighbor, err := t.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: neighborID, UserID: userID})
+	if errors.Is(err, domain.ErrNotFound) {
+		e := domain.NewFailedPreconditionError("REFERENCE_NOT_FOUND", "task "+neighborID+" not found")
+		e.Resource = "task"
+		e.Field = field
+		return nil, e
+	}
+	if err != nil {
+		return nil, err
+	}
+	if neighbor.ProjectID != current.ProjectID {
+		e := domain.NewFailedPreconditionError("NEIGHBOR_PROJECT_MISMATCH", "task "+neighborID+" belongs to another project")
+		e.Resource = "task"
+		e.Field = field
+		return nil, e
+	}
+	return neighbor, nil
+}
+
+// updateChanges lists the fields of current that param changes.
+// replaceTaskTags replaces the tags of a task with tagIDs.
+func (t *taskService) replaceTaskTags(ctx context.Context, tx *sql.Tx, taskID, userID string, tagIDs []string) error {
+	if err := t.taskTagRepo.DeleteTaskTags(ctx, tx, domain.DeleteTaskTagParam{TaskID: taskID}); err != nil {
+		return err
+	}
+
+	if len(tagIDs) == 0 || tagIDs[0] == "" {
+		return nil
+	}
+	for _, tagID := range tagIDs {
+		taskTagParam := domain.CreateTaskTagParam{
+			TaskID: taskID,
+			TagID:  tagID,
+			UserID: userID,
+		}
+		if err := t.taskTagRepo.CreateTaskTag(ctx, tx, taskTagParam); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statusForIsEnd returns the first status of the workflow of current that
+// current may move to and that is an end status when isEnd is set, or is not
+// one otherwise.
+func (t *taskService) statusForIsEnd(ctx context.Context, tx *sql.Tx, current *domain.Task, isEnd bool) (*domain.WorkflowStatus, error) {
+	statuses, err := t.workflowRepo.ListWorkflowStatus(ctx, domain.ListWorkflowParam{ProjectID: current.ProjectID})
+	if err != nil {
+		return nil, err
+	}
+	for i := range statuses {
+		if statuses[i].IsEnd != isEnd {
+			continue
+		}
+		allowed, err := t.workflowRepo.IsTransitionAllowed(ctx, tx, domain.IsTransitionAllowedParam{FromStatusID: current.StatusID, ToStatusID: statuses[i].ID})
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			return &statuses[i], nil
+		}
+	}
+	target := "an end status"
+	if !isEnd {
+		target = "a status that is not an end status"
+	}
+	e := domain.NewFailedPreconditionError("TRANSITION_NOT_ALLOWED", "the workflow does not allow moving a task from "+current.Status+" to "+target)
+	e.Resource = "task"
+	e.Field = "is_end"
+	return nil, e
+}
+
+// applyUpdate returns current with the fields in the mask of param replaced.
+func applyUpdate(current *domain.Task, param domain.UpdateTaskParam) domain.Task {
+	updated := *current
+	for _, field := range param.UpdateMask {
+		switch field {
+		case domain.TaskFieldTitle:
+			updated.Title = param.Title
+		case domain.TaskFieldDescription:
+			updated.Description = param.Description
+		case domain.TaskFieldLimitedAt:
+			updated.LimitedAt = param.LimitedAt
+		case domain.TaskFieldParentID:
+			updated.ParentID = param.ParentID
+		case domain.TaskFieldPriority:
+			updated.Priority = param.Priority
+		case domain.TaskFieldRecurrence:
+			updated.Recurrence = param.Recurrence
+			updated.RecurrenceStart = param.RecurrenceStart
+		}
+	}
+	return updated
+}
+
+func updateChanges(current *domain.Task, currentTagIDs []string, param domain.UpdateTaskParam, tagIDs []string) []domain.FieldChange {
+	updated := applyUpdate(current, param)
+	if !slices.Contains(param.UpdateMask, domain.TaskFieldTagIDs) {
+		tagIDs = currentTagIDs
+	}
+	return diffTaskFields(historyFields, taskFieldValues(*current, currentTagIDs), taskFieldValues(updated, tagIDs))
+}
+
+func parentProjectMismatchError(parentID string) error {
+	e := domain.NewFailedPreconditionError("PARENT_PROJECT_MISMATCH", "parent task "+parentID+" belongs to another project")
+	e.Resource = "task"
+	e.Field = "parent_id"
+	return e
+}
+
+// transitionTask moves current to status on behalf of userID and returns the
+// ID of the next occurrence when a recurring task is completed.
+func (t *taskService) transitionTask(ctx context.Context, tx *sql.Tx, current *domain.Task, userID string, status *domain.WorkflowStatus, completion task.TransitionTaskRequest_SubtaskCompletion, force bool) (string, error) {
+	allowed, err := t.workflowRepo.IsTransitionAllowed(ctx, tx, domain.IsTransitionAllowedParam{FromStatusID: current.StatusID, ToStatusID: status.ID})
+	if err != nil {
+		return "", err
+	}
+	if !allowed {
+		e := domain.NewFailedPreconditionError("TRANSITION_NOT_ALLOWED", "the workflow does not allow moving a task from "+current.Status+" to "+status.Name)
+		e.Resource = "task"
+		e.Field = "status"
+		return "", e
+	}
+	if status.IsEnd {
+		if !force {
+			if err := t.checkBlockers(ctx, tx, current.ID); err != nil {
+				return "", err
+			}
+		}
+		if err := t.completeSubtasks(ctx, tx, current.ID, userID, status.ID, status.Name, completion); err != nil {
+			return "", err
+		}
+	}
+	// 繰り返しのタスクは完了したときに次の回を作る
+	var nextTaskID string
+	if status.IsEnd && !current.IsEnd && current.Recurrence != "" {
+		if nextTaskID, err = t.createNextOccurrence(ctx, tx, current, userID); err != nil {
+			return "", err
+		}
+	}
+	err = t.taskRepo.TransitionTask(ctx, tx, domain.TransitionTaskParam{
+		ID:           current.ID,
+		UserID:       userID,
+		FromStatusID: current.StatusID,
+		StatusID:     status.ID,
+		IsEnd:        status.IsEnd,
+	})
+	if err != nil {
+		return "", err
+	}
+	err = t.recordHistory(ctx, tx, current.ID, userID, domain.TaskHistoryUpdated, []domain.FieldChange{
+		{Field: domain.TaskFieldStatus, Before: current.Status, After: status.Name},
+	})
+	if err != nil {
+		return "", err
+	}
+	return nextTaskID, nil
+}
+
+// completeSubtasks applies the completion policy to the subtasks of a task
+// that is moving to the end status statusID.
+func (t *taskService) completeSubtasks(ctx context.Context, tx *sql.Tx, taskID, userID, statusID, statusName string, completion task.TransitionTaskRequest_SubtaskCompletion) error {
+	if completion == task.TransitionTaskRequest_SUBTASK_COMPLETION_CASCADE {
+		previous, err := t.taskRepo.CompleteSubtask(ctx, tx, domain.CompleteSubtaskParam{TaskID: taskID, UserID: userID, StatusID: statusID})
+		if err != nil {
+			return err
+		}
+		for _, subtaskID := range slices.Sorted(maps.Keys(previous)) {
+			if err := t.recordHistory(ctx, tx, subtaskID, userID, domain.TaskHistoryUpdated, []domain.FieldChange{
+				{Field: domain.TaskFieldStatus, Before: previous[subtaskID], After: statusName},
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	open, err := t.taskRepo.CountOpenSubtask(ctx, tx, domain.SubtaskParam{TaskID: taskID})
+	if err != nil {
+		return err
+	}
+	if open > 0 {
+		e := domain.NewFailedPreconditionError("SUBTASKS_NOT_DONE", "the task has subtasks that are not done")
+		e.Resource = "task"
+		e.Field = "status"
+		return e
+	}
+	return nil
+}
+
+// checkBlockers reports an error if a task that is moving to an end status is
+// still blocked by open tasks.
+func (t *taskService) checkBlockers(ctx context.Context, tx *sql.Tx, taskID string) error {
+	open, err := t.dependencyRepo.CountOpenBlocker(ctx, tx, domain.CountOpenBlockerParam{TaskID: taskID})
+	if err != nil {
+		return err
+	}
+	if open > 0 {
+		e := domain.NewFailedPreconditionError("TASK_BLOCKED", "the task is blocked by tasks that are not done")
+		e.Resource = "task"
+		e.Field = "status"
+		return e
+	}
+	return nil
+}
+
+func toProtoEventType(eventType domain.TaskEventType) task.WatchTasksResponse_EventType {
+	switch eventType {
+	case domain.TaskEventCreated:
+		return task.WatchTasksResponse_EVENT_TYPE_CREATED
+	case domain.TaskEventUpdated:
+		return task.WatchTasksResponse_EVENT_TYPE_UPDATED
+	case domain.TaskEventDeleted:
+		return task.WatchTasksResponse_EVENT_TYPE_DELETED
+	}
+	return task.WatchTasksResponse_EVENT_TYPE_UNSPECIFIED
+}
+
+func toProtoTask(t domain.Task, tags []domain.Tag, progress domain.TaskProgress) *task.Task {
+	var protoTags []*task.Tag
+	for _, tag := range tags {
+		protoTags = append(protoTags, &task.Tag{
+			Id:        tag.ID,
+			Name:      tag.Name,
+			ProjectId: tag.ProjectID,
+		})
+	}
+	res := &task.Task{
+		Id:              t.ID,
+		Title:           t.Title,
+		Description:     t.Description,
+		CreatedAt:       timestamppb.New(t.CreatedAt),
+		UpdatedAt:       timestamppb.New(t.UpdateAt),
+		LimitedAt:       timestamppb.New(t.LimitedAt),
+		IsEnd:           t.IsEnd,
+		Tags:            protoTags,
+		ProjectId:       t.ProjectID,
+		ParentId:        t.ParentID,
+		Progress:        progress.Percent(t.IsEnd),
+		Blocked:         t.Blocked,
+		Status:          t.Status,
+		StatusChangedBy: t.StatusChangedBy,
+		Priority:        toProtoTaskPriority(t.Priority),
+		Position:        t.Position,
+		Recurrence:      t.Recurrence,
+		CommentCount:    t.CommentCount,
+		Etag:            formatETag(t.Version),
+	}
+	if t.StatusChangedAt != nil {
+		res.StatusChangedAt = timestamppb.New(*t.StatusChangedAt)
+	}
+	if t.DeletedAt != nil {
+		res.DeletedAt = timestamppb.New(*t.DeletedAt)
+		res.DeletedBy = t.DeletedBy
+	}
+	return res
+}
+
+func parseTaskOrder(orderBy string) (string, bool, error) {
+	fields := strings.Fields(orderBy)
+	if len(fields) == 0 {
+		return "", false, nil
+	}
+	switch fields[0] {
+	case domain.TaskOrderCreatedAt, domain.TaskOrderUpdatedAt, domain.TaskOrderLimitedAt, domain.TaskOrderTitle, domain.TaskOrderPriority, domain.TaskOrderPosition:
+	default:
+		return "", false, domain.NewInvalidArgumentError("order_by", "unsupported order_by field: "+fields[0])
+	}
+	if len(fields) == 1 {
+		return fields[0], false, nil
+	}
+	if len(fields) == 2 && (fields[1] == "asc" || fields[1] == "desc") {
+		return fields[0], fields[1] == "desc", nil
+	}
+	return "", false, domain.NewInvalidArgumentError("order_by", "order_by must be \"<field> [asc|desc]\"")
+}
+
+func taskOrderValue(t domain.Task, orderBy string) string {
+	switch orderBy {
+	case domain.TaskOrderCreatedAt:
+		return t.CreatedAt.Format(time.RFC3339Nano)
+	case domain.TaskOrderUpdatedAt:
+		return t.UpdateAt.Format(time.RFC3339Nano)
+	case domain.TaskOrderLimitedAt:
+		return t.LimitedAt.Format(time.RFC3339Nano)
+	case domain.TaskOrderTitle:
+		return t.Title
+	case domain.TaskOrderPriority:
+		return strconv.Itoa(int(t.Priority))
+	case domain.TaskOrderPosition:
+		return t.Position
+	}
+	return ""
+}
+
+func toTaskPriority(p task.TaskPriority) domain.TaskPriority {
+	switch p {
+	case task.TaskPriority_TASK_PRIORITY_LOW:
+		return domain.TaskPriorityLow
+	case task.TaskPriority_TASK_PRIORITY_MEDIUM:
+		return domain.TaskPriorityMedium
+	case task.TaskPriority_TASK_PRIORITY_HIGH:
+		return domain.TaskPriorityHigh
+	case task.TaskPriority_TASK_PRIORITY_URGENT:
+		return domain.TaskPriorityUrgent
+	}
+	return domain.TaskPriorityNone
+}
+
+func toProtoTaskPriority(p domain.TaskPriority) task.TaskPriority {
+	switch p {
+	case domain.TaskPriorityLow:
+		return task.TaskPriority_TASK_PRIORITY_LOW
+	case domain.TaskPriorityMedium:
+		return task.TaskPriority_TASK_PRIORITY_MEDIUM
+	case domain.TaskPriorityHigh:
+		return task.TaskPriority_TASK_PRIORITY_HIGH
+	case domain.TaskPriorityUrgent:
+		return task.TaskPriority_TASK_PRIORITY_URGENT
+	}
+	return task.TaskPriority_TASK_PRIORITY_NONE
+}
+
+func toTaskFilter(f *task.TaskFilter) domain.TaskFilter {
+	if f == nil {
+		return domain.TaskFilter{}
+	}
+	return domain.TaskFilter{
+		IsEnd:         f.IsEnd,
+		TagIDs:        f.TagIds,
+		MatchAllTags:  f.TagMatch == task.TaskFilter_TAG_MATCH_ALL,
+		LimitedBefore: toTimePtr(f.LimitedBefore),
+		LimitedAfter:  toTimePtr(f.LimitedAfter),
+		CreatedBefore: toTimePtr(f.CreatedBefore),
+		CreatedAfter:  toTimePtr(f.CreatedAfter),
+		TitleContains: f.TitleContains,
+		ProjectID:     f.ProjectId,
+		ParentID:      f.ParentId,
+		Status:        f.Status,
+	}
+}
+
+func toTimePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
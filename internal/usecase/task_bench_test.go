@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	task "github.com/sikigasa/task-controller/proto/v1"
+)
+
+// クエリ数を数えるだけのリポジトリ。使わないメソッドは埋め込んだinterfaceに任せる
+type countingTaskRepo struct {
+	infra.TaskRepo
+	queries *int
+	tasks   []domain.Task
+}
+
+func (r *countingTaskRepo) ListTask(ctx context.Context, arg domain.ListTaskParam) ([]domain.Task, error) {
+	*r.queries++
+	return r.tasks[:min(int(arg.Limit), len(r.tasks))], nil
+}
+
+func (r *countingTaskRepo) CountTask(ctx context.Context, arg domain.ListTaskParam) (int32, error) {
+	*r.queries++
+	return int32(len(r.tasks)), nil
+}
+
+func (r *countingTaskRepo) ListTaskProgress(ctx context.Context, arg domain.ListTaskProgressParam) (map[string]domain.TaskProgress, error) {
+	*r.queries++
+	return map[string]domain.TaskProgress{}, nil
+}
+
+type countingTagRepo struct {
+	infra.TagRepo
+}
+
+type countingTaskTagRepo struct {
+	infra.TaskTagRepo
+	queries *int
+	tags    map[string][]domain.Tag
+}
+
+func (r *countingTaskTagRepo) ListTagsByTaskIDs(ctx context.Context, arg domain.ListTaskTagParam) (map[string][]domain.Tag, error) {
+	*r.queries++
+	tags := make(map[string][]domain.Tag, len(arg.TaskIDs))
+	for _, id := range arg.TaskIDs {
+		tags[id] = r.tags[id]
+	}
+	return tags, nil
+}
+
+// BenchmarkListTask checks that a page of tasks is loaded with the same number
+// of queries regardless of how many tasks and tags it contains.
+func BenchmarkListTask(b *testing.B) {
+	const tagsPerTask = 5
+
+	for _, pageSize := range []int32{10, 100} {
+		b.Run(fmt.Sprintf("page=%d", pageSize), func(b *testing.B) {
+			tasks := make([]domain.Task, pageSize)
+			tags := make(map[string][]domain.Tag, pageSize)
+			for i := range tasks {
+				tasks[i] = domain.Task{ID: fmt.Sprintf("task%03d", i), Title: "ベンチマーク", LimitedAt: time.Now()}
+				for j := 0; j < tagsPerTask; j++ {
+					tags[tasks[i].ID] = append(tags[tasks[i].ID], domain.Tag{ID: fmt.Sprintf("tag%d", j), Name: "タグ"})
+				}
+			}
+
+			queries := 0
+			taskService := NewTaskService(
+				&countingTaskRepo{queries: &queries, tasks: tasks},
+				&countingTagRepo{},
+				&countingTaskTagRepo{queries: &queries, tags: tags},
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				res, err := taskService.ListTask(auth.WithUserID(context.Background(), "bench_user"), &task.ListTaskRequest{Limit: pageSize})
+				if err != nil {
+					b.Fatalf("expected no error, got %v", err)
+				}
+				if len(res.Tasks) != int(pageSize) || len(res.Tasks[0].Tags) != tagsPerTask {
+					b.Fatalf("expected %d tasks with %d tags, got %d", pageSize, tagsPerTask, len(res.Tasks))
+				}
+			}
+			b.StopTimer()
+
+			// タスク一覧・件数・タグ・進捗の4クエリで1ページを取得する
+			perPage := float64(queries) / float64(b.N)
+			b.ReportMetric(perPage, "queries/op")
+			if perPage != 4 {
+				b.Errorf("expected 4 queries per page, got %v", perPage)
+			}
+		})
+	}
+}
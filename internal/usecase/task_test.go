@@ -3,22 +3,32 @@ package usecase
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sikigasa/task-controller/internal/auth"
+	"github.com/sikigasa/task-controller/internal/domain"
 	"github.com/sikigasa/task-controller/internal/infra"
 	postgresDriver "github.com/sikigasa/task-controller/internal/infra/driver"
 	task "github.com/sikigasa/task-controller/proto/v1"
+	"github.com/sikigasa/task-controller/proto/v1/v1connect"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
-func setupTestDB(t *testing.T) (*sql.DB, func()) {
+func setupTestDB(t *testing.T) (*sql.DB, string, func()) {
 	ctx := context.Background()
 
 	// PostgreSQLコンテナの起動
@@ -57,6 +67,7 @@ func setupTestDB(t *testing.T) (*sql.DB, func()) {
 	if err := createTables(db); err != nil {
 		t.Fatalf("failed to create tables: %v", err)
 	}
+	createTestUser(t, db, testUserID)
 
 	// クリーンアップ関数
 	cleanup := func() {
@@ -66,61 +77,70 @@ func setupTestDB(t *testing.T) (*sql.DB, func()) {
 		}
 	}
 
-	return db, cleanup
+	return db, connStr, cleanup
 }
 
 func createTables(db *sql.DB) error {
-	queries := []string{
-		`CREATE TABLE IF NOT EXISTS task (
-            id VARCHAR(255) PRIMARY KEY,
-            title VARCHAR(255) NOT NULL,
-            description TEXT,
-            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-            updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-            limited_at TIMESTAMP,
-            is_end BOOLEAN DEFAULT FALSE
-        )`,
-		`CREATE TABLE IF NOT EXISTS tag (
-            id VARCHAR(255) PRIMARY KEY,
-            name VARCHAR(255) NOT NULL UNIQUE
-        )`,
-		`CREATE TABLE IF NOT EXISTS task_tag (
-            task_id VARCHAR(255) REFERENCES task(id) ON DELETE CASCADE,
-            tag_id VARCHAR(255) REFERENCES tag(id) ON DELETE CASCADE,
-            PRIMARY KEY (task_id, tag_id)
-        )`,
+	// 本番と同じスキーマにするためdb/migrationsを順に適用する
+	files, err := filepath.Glob("../../db/migrations/*.up.sql")
+	if err != nil {
+		return err
 	}
-
-	for _, query := range queries {
-		if _, err := db.Exec(query); err != nil {
+	for _, file := range files {
+		query, err := os.ReadFile(file)
+		if err != nil {
 			return err
 		}
+		if _, err := db.Exec(string(query)); err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(file), err)
+		}
 	}
 	return nil
 }
 
-func setupTestService(t *testing.T, db *sql.DB) task.TaskServiceServer {
+func setupTestService(t *testing.T, db *sql.DB, connStr string) v1connect.TaskServiceHandler {
 	taskRepo := infra.NewTaskRepo(db)
 	tagRepo := infra.NewTagRepo(db)
 	taskTagRepo := infra.NewTaskTagRepo(db)
 	tx := postgresDriver.NewPostgresTransaction(db)
 
-	return NewTaskService(taskRepo, tagRepo, taskTagRepo, tx)
+	listener := pq.NewListener(connStr, time.Second, time.Minute, nil)
+	t.Cleanup(func() { listener.Close() })
+	taskWatcher, err := infra.NewTaskWatcher(listener)
+	if err != nil {
+		t.Fatalf("failed to listen task events: %v", err)
+	}
+
+	return NewTaskService(taskRepo, tagRepo, taskTagRepo, infra.NewProjectRepo(db), infra.NewTaskDependencyRepo(db), infra.NewWorkflowRepo(db), infra.NewTaskHistoryRepo(db), taskWatcher, tx)
+}
+
+// テストのリクエストはすべてこのユーザーとして実行する
+const testUserID = "test_user"
+
+func testUserContext() context.Context {
+	return auth.WithUserID(context.Background(), testUserID)
+}
+
+func createTestUser(t *testing.T, db *sql.DB, id string) {
+	query := `INSERT INTO users (id, email, password_hash) VALUES ($1, $2, '')`
+	if _, err := db.Exec(query, id, id+"@example.com"); err != nil {
+		t.Fatalf("failed to create test user: %v", err)
+	}
 }
 
 func createTestTag(t *testing.T, db *sql.DB, id, name string) {
-	query := `INSERT INTO tag (id, name) VALUES ($1, $2) ON CONFLICT (id) DO NOTHING`
-	_, err := db.Exec(query, id, name)
+	query := `INSERT INTO tag (id, owner_id, name) VALUES ($1, $2, $3) ON CONFLICT (id) DO NOTHING`
+	_, err := db.Exec(query, id, testUserID, name)
 	if err != nil {
 		t.Fatalf("failed to create test tag: %v", err)
 	}
 }
 
 func TestTask(t *testing.T) {
-	db, cleanup := setupTestDB(t)
+	db, connStr, cleanup := setupTestDB(t)
 	defer cleanup()
 
-	taskService := setupTestService(t, db)
+	taskService := setupTestService(t, db, connStr)
 
 	t.Run("CreateTask", func(t *testing.T) {
 		testCreateTask(t, taskService, db)
@@ -141,9 +161,13 @@ func TestTask(t *testing.T) {
 	t.Run("DeleteTask", func(t *testing.T) {
 		testDeleteTask(t, taskService, db)
 	})
+
+	t.Run("WatchTasks", func(t *testing.T) {
+		testWatchTasks(t, taskService)
+	})
 }
 
-func testCreateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
+func testCreateTask(t *testing.T, taskService v1connect.TaskServiceHandler, db *sql.DB) {
 	t.Run("正常系_タグありの場合", func(t *testing.T) {
 		// テスト用タグを作成
 		createTestTag(t, db, "tag1", "テストタグ1")
@@ -156,7 +180,7 @@ func testCreateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			TagIds:      []string{"tag1", "tag2"},
 		}
 
-		res, err := taskService.CreateTask(context.Background(), req)
+		res, err := taskService.CreateTask(testUserContext(), req)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
 		}
@@ -184,7 +208,7 @@ func testCreateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			TagIds:      []string{},
 		}
 
-		res, err := taskService.CreateTask(context.Background(), req)
+		res, err := taskService.CreateTask(testUserContext(), req)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
 		}
@@ -193,9 +217,23 @@ func testCreateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			t.Errorf("expected valid response with ID")
 		}
 	})
+
+	t.Run("異常系_存在しないタグ", func(t *testing.T) {
+		req := &task.CreateTaskRequest{
+			Title:       "存在しないタグのタスク",
+			Description: "存在しないタグの説明",
+			LimitedAt:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+			TagIds:      []string{"non-existent-tag"},
+		}
+
+		_, err := taskService.CreateTask(testUserContext(), req)
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error for non-existent tag, got %v", err)
+		}
+	})
 }
 
-func testGetTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
+func testGetTask(t *testing.T, taskService v1connect.TaskServiceHandler, db *sql.DB) {
 	t.Run("正常系", func(t *testing.T) {
 		// テスト用タグとタスクを作成
 		createTestTag(t, db, "get_tag1", "取得テストタグ")
@@ -207,7 +245,7 @@ func testGetTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
 			TagIds:      []string{"get_tag1"},
 		}
 
-		createRes, err := taskService.CreateTask(context.Background(), createReq)
+		createRes, err := taskService.CreateTask(testUserContext(), createReq)
 		if err != nil {
 			t.Fatalf("failed to create task: %v", err)
 		}
@@ -217,7 +255,7 @@ func testGetTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
 			Id: createRes.Id,
 		}
 
-		res, err := taskService.GetTask(context.Background(), getReq)
+		res, err := taskService.GetTask(testUserContext(), getReq)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
 		}
@@ -240,14 +278,38 @@ func testGetTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
 			Id: "non-existent-id",
 		}
 
-		_, err := taskService.GetTask(context.Background(), getReq)
-		if err == nil {
-			t.Errorf("expected error for non-existent task, got nil")
+		_, err := taskService.GetTask(testUserContext(), getReq)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error for non-existent task, got %v", err)
+		}
+	})
+
+	t.Run("異常系_他のユーザーのタスク", func(t *testing.T) {
+		createRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:     "他のユーザーから見えないタスク",
+			LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		createTestUser(t, db, "other_user")
+		otherCtx := auth.WithUserID(context.Background(), "other_user")
+		_, err = taskService.GetTask(otherCtx, &task.GetTaskRequest{Id: createRes.Id})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error for other user's task, got %v", err)
+		}
+	})
+
+	t.Run("異常系_未認証", func(t *testing.T) {
+		_, err := taskService.GetTask(context.Background(), &task.GetTaskRequest{Id: "non-existent-id"})
+		if !errors.Is(err, domain.ErrUnauthenticated) {
+			t.Errorf("expected unauthenticated error, got %v", err)
 		}
 	})
 }
 
-func testListTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
+func testListTask(t *testing.T, taskService v1connect.TaskServiceHandler, db *sql.DB) {
 	t.Run("正常系", func(t *testing.T) {
 		// 複数のテストタスクを作成
 		for i := 0; i < 3; i++ {
@@ -257,7 +319,7 @@ func testListTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB)
 				LimitedAt:   timestamppb.New(time.Now().Add(24 * time.Hour)),
 				TagIds:      []string{},
 			}
-			_, err := taskService.CreateTask(context.Background(), req)
+			_, err := taskService.CreateTask(testUserContext(), req)
 			if err != nil {
 				t.Fatalf("failed to create task %d: %v", i, err)
 			}
@@ -268,7 +330,7 @@ func testListTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB)
 			Offset: 0,
 		}
 
-		res, err := taskService.ListTask(context.Background(), req)
+		res, err := taskService.ListTask(testUserContext(), req)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
 		}
@@ -289,7 +351,7 @@ func testListTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB)
 			Offset: 0,
 		}
 
-		res, err := taskService.ListTask(context.Background(), req)
+		res, err := taskService.ListTask(testUserContext(), req)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
 		}
@@ -302,9 +364,136 @@ func testListTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB)
 			t.Errorf("expected at most 2 tasks, got %d", len(res.Tasks))
 		}
 	})
+
+	t.Run("正常系_ページトークン", func(t *testing.T) {
+		first, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{Limit: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if first.NextPageToken == "" {
+			t.Fatalf("expected next page token, got empty string")
+		}
+
+		if first.TotalSize < 3 {
+			t.Errorf("expected total size at least 3, got %d", first.TotalSize)
+		}
+
+		second, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{Limit: 2, PageToken: first.NextPageToken})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(second.Tasks) == 0 {
+			t.Fatalf("expected tasks on second page, got empty list")
+		}
+
+		// 2ページ目は1ページ目の最後のタスクより後に作成されたタスクのみ
+		last := first.Tasks[len(first.Tasks)-1].Id
+		for _, got := range second.Tasks {
+			if got.Id <= last {
+				t.Errorf("expected task after %v, got %v", last, got.Id)
+			}
+		}
+	})
+
+	t.Run("正常系_フィルタと並び替え", func(t *testing.T) {
+		createTestTag(t, db, "filter_tag1", "フィルタテストタグ1")
+		createTestTag(t, db, "filter_tag2", "フィルタテストタグ2")
+
+		titles := []string{"フィルタC", "フィルタA", "フィルタB"}
+		tagIDs := [][]string{{"filter_tag1", "filter_tag2"}, {"filter_tag1"}, {}}
+		for i, title := range titles {
+			req := &task.CreateTaskRequest{
+				Title:       title,
+				Description: "フィルタテストの説明",
+				LimitedAt:   timestamppb.New(time.Now().Add(time.Duration(i+1) * time.Hour)),
+				TagIds:      tagIDs[i],
+			}
+			if _, err := taskService.CreateTask(testUserContext(), req); err != nil {
+				t.Fatalf("failed to create task %d: %v", i, err)
+			}
+		}
+
+		res, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{
+			Filter:  &task.TaskFilter{TitleContains: "フィルタ"},
+			OrderBy: "title desc",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.Tasks) != 3 || res.TotalSize != 3 {
+			t.Fatalf("expected 3 tasks, got %d (total %d)", len(res.Tasks), res.TotalSize)
+		}
+		for i, want := range []string{"フィルタC", "フィルタB", "フィルタA"} {
+			if res.Tasks[i].Title != want {
+				t.Errorf("expected task %d to be %v, got %v", i, want, res.Tasks[i].Title)
+			}
+		}
+
+		// 並び替えたままページングできること
+		first, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{
+			Limit:   2,
+			Filter:  &task.TaskFilter{TitleContains: "フィルタ"},
+			OrderBy: "title desc",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		second, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{
+			Limit:     2,
+			Filter:    &task.TaskFilter{TitleContains: "フィルタ"},
+			OrderBy:   "title desc",
+			PageToken: first.NextPageToken,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(second.Tasks) != 1 || second.Tasks[0].Title != "フィルタA" {
+			t.Errorf("expected only フィルタA on second page, got %v", second.Tasks)
+		}
+
+		all, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{
+			Filter: &task.TaskFilter{TagIds: []string{"filter_tag1", "filter_tag2"}, TagMatch: task.TaskFilter_TAG_MATCH_ALL},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(all.Tasks) != 1 || all.Tasks[0].Title != "フィルタC" {
+			t.Errorf("expected only フィルタC to have all tags, got %v", all.Tasks)
+		}
+
+		anyTag, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{
+			Filter: &task.TaskFilter{TagIds: []string{"filter_tag1", "filter_tag2"}, TagMatch: task.TaskFilter_TAG_MATCH_ANY},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(anyTag.Tasks) != 2 {
+			t.Errorf("expected 2 tasks to have any of the tags, got %d", len(anyTag.Tasks))
+		}
+	})
+
+	t.Run("異常系_並び替えが異なるページトークン", func(t *testing.T) {
+		first, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{Limit: 1, OrderBy: "title"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		_, err = taskService.ListTask(testUserContext(), &task.ListTaskRequest{Limit: 1, OrderBy: "created_at", PageToken: first.NextPageToken})
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_不正なページトークン", func(t *testing.T) {
+		_, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{PageToken: "invalid"})
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	})
 }
 
-func testUpdateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
+func testUpdateTask(t *testing.T, taskService v1connect.TaskServiceHandler, db *sql.DB) {
 	t.Run("正常系", func(t *testing.T) {
 		// テスト用タスクを作成
 		createReq := &task.CreateTaskRequest{
@@ -314,7 +503,7 @@ func testUpdateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			TagIds:      []string{},
 		}
 
-		createRes, err := taskService.CreateTask(context.Background(), createReq)
+		createRes, err := taskService.CreateTask(testUserContext(), createReq)
 		if err != nil {
 			t.Fatalf("failed to create task: %v", err)
 		}
@@ -325,11 +514,10 @@ func testUpdateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			Title:       "更新後タスク",
 			Description: "更新後の説明",
 			LimitedAt:   timestamppb.New(time.Now().Add(48 * time.Hour)),
-			IsEnd:       true,
 			TagIds:      []string{},
 		}
 
-		res, err := taskService.UpdateTask(context.Background(), updateReq)
+		res, err := taskService.UpdateTask(testUserContext(), updateReq)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
 		}
@@ -343,7 +531,7 @@ func testUpdateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			Id: createRes.Id,
 		}
 
-		getRes, err := taskService.GetTask(context.Background(), getReq)
+		getRes, err := taskService.GetTask(testUserContext(), getReq)
 		if err != nil {
 			t.Errorf("failed to get updated task: %v", err)
 		}
@@ -352,13 +540,121 @@ func testUpdateTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			t.Errorf("expected updated title '更新後タスク', got %v", getRes.Task.Title)
 		}
 
-		if !getRes.Task.IsEnd {
-			t.Errorf("expected task to be marked as end")
+		if getRes.Task.Description != "更新後の説明" {
+			t.Errorf("expected updated description '更新後の説明', got %v", getRes.Task.Description)
+		}
+	})
+
+	t.Run("正常系_update_maskによる部分更新", func(t *testing.T) {
+		createTestTag(t, db, "partial_tag1", "部分更新テストタグ")
+
+		createReq := &task.CreateTaskRequest{
+			Title:       "部分更新前タスク",
+			Description: "部分更新前の説明",
+			LimitedAt:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+			TagIds:      []string{"partial_tag1"},
+		}
+
+		createRes, err := taskService.CreateTask(testUserContext(), createReq)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		// limited_atのみを更新する
+		limitedAt := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+		updateReq := &task.UpdateTaskRequest{
+			Id:         createRes.Id,
+			LimitedAt:  timestamppb.New(limitedAt),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"limited_at"}},
+		}
+
+		if _, err := taskService.UpdateTask(testUserContext(), updateReq); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		getRes, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: createRes.Id})
+		if err != nil {
+			t.Fatalf("failed to get updated task: %v", err)
+		}
+
+		if !getRes.Task.LimitedAt.AsTime().Equal(limitedAt) {
+			t.Errorf("expected limited_at %v, got %v", limitedAt, getRes.Task.LimitedAt.AsTime())
+		}
+
+		if getRes.Task.Title != "部分更新前タスク" || getRes.Task.Description != "部分更新前の説明" {
+			t.Errorf("expected title and description to be kept, got %v, %v", getRes.Task.Title, getRes.Task.Description)
+		}
+
+		if len(getRes.Task.Tags) != 1 {
+			t.Errorf("expected tags to be kept, got %d", len(getRes.Task.Tags))
+		}
+	})
+
+	t.Run("正常系_update_maskなしでも優先度と繰り返しは指定したときだけ更新する", func(t *testing.T) {
+		createRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:      "優先度のあるタスク",
+			LimitedAt:  timestamppb.New(time.Now().Add(24 * time.Hour)),
+			Priority:   task.TaskPriority_TASK_PRIORITY_HIGH,
+			Recurrence: "RRULE:FREQ=WEEKLY",
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		// 優先度と繰り返しを知らないクライアントからの更新
+		_, err = taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:        createRes.Id,
+			Title:     "古いクライアントからの更新",
+			LimitedAt: timestamppb.New(time.Now().Add(48 * time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		getRes, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: createRes.Id})
+		if err != nil {
+			t.Fatalf("failed to get updated task: %v", err)
+		}
+		if getRes.Task.Title != "古いクライアントからの更新" {
+			t.Errorf("expected updated title, got %v", getRes.Task.Title)
+		}
+		if getRes.Task.Priority != task.TaskPriority_TASK_PRIORITY_HIGH || getRes.Task.Recurrence == "" {
+			t.Errorf("expected priority and recurrence to be kept, got %v, %v", getRes.Task.Priority, getRes.Task.Recurrence)
+		}
+
+		_, err = taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:        createRes.Id,
+			Title:     "優先度を変更",
+			LimitedAt: timestamppb.New(time.Now().Add(48 * time.Hour)),
+			Priority:  task.TaskPriority_TASK_PRIORITY_LOW,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		getRes, err = taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: createRes.Id})
+		if err != nil {
+			t.Fatalf("failed to get updated task: %v", err)
+		}
+		if getRes.Task.Priority != task.TaskPriority_TASK_PRIORITY_LOW {
+			t.Errorf("expected priority to be updated, got %v", getRes.Task.Priority)
+		}
+	})
+
+	t.Run("異常系_存在しないタスク", func(t *testing.T) {
+		updateReq := &task.UpdateTaskRequest{
+			Id:          "non-existent-id",
+			Description: "存在しないタスク",
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		}
+
+		_, err := taskService.UpdateTask(testUserContext(), updateReq)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
 		}
 	})
 }
 
-func testDeleteTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB) {
+func testDeleteTask(t *testing.T, taskService v1connect.TaskServiceHandler, db *sql.DB) {
 	t.Run("正常系", func(t *testing.T) {
 		// テスト用タスクを作成
 		createReq := &task.CreateTaskRequest{
@@ -368,7 +664,7 @@ func testDeleteTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			TagIds:      []string{},
 		}
 
-		createRes, err := taskService.CreateTask(context.Background(), createReq)
+		createRes, err := taskService.CreateTask(testUserContext(), createReq)
 		if err != nil {
 			t.Fatalf("failed to create task: %v", err)
 		}
@@ -378,7 +674,7 @@ func testDeleteTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			Id: createRes.Id,
 		}
 
-		res, err := taskService.DeleteTask(context.Background(), deleteReq)
+		res, err := taskService.DeleteTask(testUserContext(), deleteReq)
 		if err != nil {
 			t.Errorf("expected no error, got %v", err)
 		}
@@ -392,7 +688,7 @@ func testDeleteTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			Id: createRes.Id,
 		}
 
-		_, err = taskService.GetTask(context.Background(), getReq)
+		_, err = taskService.GetTask(testUserContext(), getReq)
 		if err == nil {
 			t.Errorf("expected error when getting deleted task, got nil")
 		}
@@ -403,9 +699,72 @@ func testDeleteTask(t *testing.T, taskService task.TaskServiceServer, db *sql.DB
 			Id: "non-existent-id",
 		}
 
-		_, err := taskService.DeleteTask(context.Background(), deleteReq)
-		if err == nil {
-			t.Errorf("expected error when deleting non-existent task, got nil")
+		_, err := taskService.DeleteTask(testUserContext(), deleteReq)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error when deleting non-existent task, got %v", err)
+		}
+	})
+}
+
+func testWatchTasks(t *testing.T, taskService v1connect.TaskServiceHandler) {
+	t.Run("正常系_作成・更新・削除の通知", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.Handle(v1connect.NewTaskServiceHandler(taskService))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mux.ServeHTTP(w, r.WithContext(auth.WithUserID(r.Context(), testUserID)))
+		}))
+		defer server.Close()
+		client := v1connect.NewTaskServiceClient(server.Client(), server.URL)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		stream, err := client.WatchTasks(ctx, &task.WatchTasksRequest{})
+		if err != nil {
+			t.Fatalf("failed to watch tasks: %v", err)
+		}
+		defer stream.Close()
+		// サーバー側で購読が始まるまで待つ
+		time.Sleep(500 * time.Millisecond)
+
+		// 通知を受けてから取得するため、操作ごとにイベントを待つ
+		receive := func(eventType task.WatchTasksResponse_EventType, id string) *task.Task {
+			t.Helper()
+			if !stream.Receive() {
+				t.Fatalf("expected %v event, got %v", eventType, stream.Err())
+			}
+			event := stream.Msg()
+			if event.Type != eventType || event.Task.Id != id {
+				t.Errorf("expected %v event for %s, got %v for %s", eventType, id, event.Type, event.Task.Id)
+			}
+			return event.Task
+		}
+
+		createRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:     "監視テストタスク",
+			LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		if created := receive(task.WatchTasksResponse_EVENT_TYPE_CREATED, createRes.Id); created.Title != "監視テストタスク" {
+			t.Errorf("expected full task in created event, got %v", created)
+		}
+
+		_, err = taskService.UpdateTask(testUserContext(), &task.UpdateTaskRequest{
+			Id:         createRes.Id,
+			Title:      "監視テストタスク(更新)",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		if err != nil {
+			t.Fatalf("failed to update task: %v", err)
+		}
+		if updated := receive(task.WatchTasksResponse_EVENT_TYPE_UPDATED, createRes.Id); updated.Title != "監視テストタスク(更新)" {
+			t.Errorf("expected updated task in updated event, got %v", updated)
+		}
+
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: createRes.Id}); err != nil {
+			t.Fatalf("failed to delete task: %v", err)
 		}
+		receive(task.WatchTasksResponse_EVENT_TYPE_DELETED, createRes.Id)
 	})
 }
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	task "github.com/sikigasa/task-controller/proto/v1"
+)
+
+// ゴミ箱は削除が新しい順に並べる
+const trashOrder = domain.TaskOrderDeletedAt + " desc"
+
+func (t *taskService) ListTrash(ctx context.Context, req *task.ListTrashRequest) (*task.ListTrashResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+	token, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	if token.LastID != "" && token.OrderBy != trashOrder {
+		return nil, domain.NewInvalidArgumentError("page_token", "page token is not for the trash")
+	}
+	// 次のページの有無を判定するために1件多く取得する
+	param := domain.ListTaskParam{
+		UserID:     userID,
+		Limit:      req.Limit + 1,
+		AfterID:    token.LastID,
+		AfterValue: token.LastValue,
+		Filter:     domain.TaskFilter{ProjectID: req.ProjectId, Deleted: true},
+		OrderBy:    domain.TaskOrderDeletedAt,
+		Desc:       true,
+	}
+
+	tasks, err := t.taskRepo.ListTask(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	var nextPageToken string
+	if len(tasks) > int(req.Limit) {
+		tasks = tasks[:req.Limit]
+		last := tasks[len(tasks)-1]
+		nextPageToken = encodePageToken(pageToken{
+			LastID:    last.ID,
+			LastValue: last.DeletedAt.Format(time.RFC3339Nano),
+			OrderBy:   trashOrder,
+		})
+	}
+	totalSize, err := t.taskRepo.CountTask(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := make([]string, 0, len(tasks))
+	for _, taskDetail := range tasks {
+		taskIDs = append(taskIDs, taskDetail.ID)
+	}
+	tags, err := t.taskTagRepo.ListTagsByTaskIDs(ctx, domain.ListTaskTagParam{TaskIDs: taskIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskList []*task.Task
+	for _, taskDetail := range tasks {
+		taskList = append(taskList, toProtoTask(taskDetail, tags[taskDetail.ID], domain.TaskProgress{}))
+	}
+
+	return &task.ListTrashResponse{
+		Tasks:         taskList,
+		NextPageToken: nextPageToken,
+		TotalSize:     totalSize,
+	}, nil
+}
+
+func (t *taskService) RestoreTask(ctx context.Context, req *task.RestoreTaskRequest) (*task.RestoreTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := authorizeDeletedTaskWrite(ctx, t.taskRepo, t.projectRepo, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 親がゴミ箱にある間は、サブタスクだけを戻せない
+	if current.ParentID != "" {
+		parent, err := t.taskRepo.GetTask(ctx, domain.GetTaskParam{ID: current.ParentID, UserID: userID, IncludeDeleted: true})
+		if err != nil {
+			return nil, err
+		}
+		if parent.DeletedAt != nil {
+			e := domain.NewFailedPreconditionError("PARENT_DELETED", "parent task "+current.ParentID+" is in the trash")
+			e.Resource = "task"
+			e.Field = "parent_id"
+			return nil, e
+		}
+	}
+
+	err = t.tx.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if err := t.taskRepo.RestoreTask(ctx, tx, domain.RestoreTaskParam{ID: req.Id}); err != nil {
+			return err
+		}
+		if err := t.recordHistory(ctx, tx, req.Id, userID, domain.TaskHistoryRestored, nil); err != nil {
+			return err
+		}
+		// ゴミ箱にある間に変わったワークフローの終了扱いを反映する
+		if current.ProjectID == "" {
+			return nil
+		}
+		return t.syncTaskEnd(ctx, tx, current.ProjectID, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.RestoreTaskResponse{
+		Success: true,
+	}, nil
+}
+
+func (t *taskService) PurgeTask(ctx context.Context, req *task.PurgeTaskRequest) (*task.PurgeTaskResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := authorizeDeletedTaskWrite(ctx, t.taskRepo, t.projectRepo, req.Id, userID); err != nil {
+		return nil, err
+	}
+
+	// 履歴は外部キーを持たないため、完全に削除した後も残る
+	if err := t.taskRepo.PurgeTask(ctx, domain.PurgeTaskParam{ID: req.Id}); err != nil {
+		return nil, err
+	}
+
+	return &task.PurgeTaskResponse{
+		Success: true,
+	}, nil
+}
+
+// 1回のクエリで完全に削除するタスクの上限
+const trashPurgeBatchSize = 100
+
+// TrashPurger permanently deletes tasks that have been in the trash for
+// longer than the retention period.
+type TrashPurger struct {
+	taskRepo  infra.TaskRepo
+	retention time.Duration
+}
+
+func NewTrashPurger(taskRepo infra.TaskRepo, retention time.Duration) *TrashPurger {
+	return &TrashPurger{
+		taskRepo:  taskRepo,
+		retention: retention,
+	}
+}
+
+// Run purges expired tasks every interval until ctx is done.
+func (p *TrashPurger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := p.PurgeExpired(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Printf("trash purge: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpired deletes the tasks moved to the trash more than the retention
+// period before now and returns how many were deleted.
+func (p *TrashPurger) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	param := domain.PurgeDeletedTaskParam{
+		DeletedBefore: now.Add(-p.retention),
+		Limit:         trashPurgeBatchSize,
+	}
+	// 大量に削除するときにロックを長く持たないよう、少しずつ削除する
+	var purged int
+	for {
+		count, err := p.taskRepo.PurgeDeletedTask(ctx, param)
+		purged += int(count)
+		if err != nil {
+			return purged, err
+		}
+		if count < trashPurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
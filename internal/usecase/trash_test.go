@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTrash(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskService := setupTestService(t, db, connStr)
+	purger := NewTrashPurger(infra.NewTaskRepo(db), time.Hour)
+	createTestTag(t, db, "trash_tag", "ゴミ箱タグ")
+
+	createRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:     "削除するタスク",
+		LimitedAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		TagIds:    []string{"trash_tag"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	parentID := createRes.Id
+	childID := createTestSubtask(t, taskService, "サブタスク", parentID)
+
+	t.Run("正常系_削除したタスクはゴミ箱に入る", func(t *testing.T) {
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: parentID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, id := range []string{parentID, childID} {
+			if _, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: id}); !errors.Is(err, domain.ErrNotFound) {
+				t.Errorf("expected not found error for %s, got %v", id, err)
+			}
+		}
+		listRes, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if listRes.TotalSize != 0 {
+			t.Errorf("expected deleted tasks to be left out, got %v", listRes.Tasks)
+		}
+
+		// 親と一緒に削除されたサブタスクは一覧に出さない
+		trashRes, err := taskService.ListTrash(testUserContext(), &task.ListTrashRequest{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(trashRes.Tasks) != 1 || trashRes.TotalSize != 1 {
+			t.Fatalf("expected only the parent in the trash, got %v", trashRes.Tasks)
+		}
+		deleted := trashRes.Tasks[0]
+		if deleted.Id != parentID || deleted.DeletedAt == nil || deleted.DeletedBy != testUserID || len(deleted.Tags) != 1 {
+			t.Errorf("unexpected task in the trash: %v", deleted)
+		}
+	})
+
+	t.Run("異常系_親がゴミ箱にあるサブタスクの復元", func(t *testing.T) {
+		_, err := taskService.RestoreTask(testUserContext(), &task.RestoreTaskRequest{Id: childID})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_復元するとサブタスクとタグも戻る", func(t *testing.T) {
+		if _, err := taskService.RestoreTask(testUserContext(), &task.RestoreTaskRequest{Id: parentID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		getRes, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: parentID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(getRes.Task.Tags) != 1 || getRes.Task.DeletedAt != nil {
+			t.Errorf("unexpected restored task: %v", getRes.Task)
+		}
+		if _, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: childID}); err != nil {
+			t.Errorf("expected subtask to be restored, got %v", err)
+		}
+
+		historyRes, err := taskService.ListTaskHistory(testUserContext(), &task.ListTaskHistoryRequest{Id: parentID, Limit: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if historyRes.History[0].Action != task.TaskHistory_ACTION_RESTORED || historyRes.History[1].Action != task.TaskHistory_ACTION_DELETED {
+			t.Errorf("unexpected history: %v", historyRes.History)
+		}
+	})
+
+	t.Run("異常系_ゴミ箱にないタスク", func(t *testing.T) {
+		_, err := taskService.RestoreTask(testUserContext(), &task.RestoreTaskRequest{Id: parentID})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		_, err = taskService.PurgeTask(testUserContext(), &task.PurgeTaskRequest{Id: parentID})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_完全削除", func(t *testing.T) {
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: childID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := taskService.PurgeTask(testUserContext(), &task.PurgeTaskRequest{Id: childID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		var count int
+		if err := db.QueryRow(`SELECT count(*) FROM task WHERE id = $1`, childID).Scan(&count); err != nil {
+			t.Fatalf("failed to count tasks: %v", err)
+		}
+		if count != 0 {
+			t.Errorf("expected task to be purged")
+		}
+	})
+
+	t.Run("正常系_保持期間を過ぎたタスクを完全に削除", func(t *testing.T) {
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: parentID}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		purged, err := purger.PurgeExpired(context.Background(), time.Now())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if purged != 0 {
+			t.Errorf("expected tasks within the retention to be kept, got %d", purged)
+		}
+
+		purged, err = purger.PurgeExpired(context.Background(), time.Now().Add(2*time.Hour))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if purged != 1 {
+			t.Errorf("expected 1 purged task, got %d", purged)
+		}
+		trashRes, err := taskService.ListTrash(testUserContext(), &task.ListTrashRequest{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(trashRes.Tasks) != 0 {
+			t.Errorf("expected empty trash, got %v", trashRes.Tasks)
+		}
+	})
+}
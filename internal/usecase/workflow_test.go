@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sikigasa/task-controller/internal/domain"
+	"github.com/sikigasa/task-controller/internal/infra"
+	postgresDriver "github.com/sikigasa/task-controller/internal/infra/driver"
+	task "github.com/sikigasa/task-controller/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWorkflow(t *testing.T) {
+	db, connStr, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	projectService := NewProjectService(infra.NewProjectRepo(db), infra.NewProjectInvitationRepo(db), infra.NewUserRepo(db), infra.NewWorkflowRepo(db), infra.NewTaskRepo(db), infra.NewTaskTagRepo(db), infra.NewTaskHistoryRepo(db), postgresDriver.NewPostgresTransaction(db))
+	taskService := setupTestService(t, db, connStr)
+
+	taskID := createTestSubtask(t, taskService, "ステータステスト", "")
+
+	t.Run("正常系_作成直後は最初のステータス", func(t *testing.T) {
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: taskID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Task.Status != "todo" || res.Task.StatusChangedBy != "" || res.Task.StatusChangedAt != nil {
+			t.Errorf("expected untouched todo task, got %v", res.Task)
+		}
+	})
+
+	t.Run("正常系_ステータスの遷移", func(t *testing.T) {
+		if _, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: taskID, Status: "in_progress"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: taskID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Task.Status != "in_progress" || res.Task.IsEnd {
+			t.Errorf("expected in_progress task, got %v", res.Task)
+		}
+		if res.Task.StatusChangedBy != testUserID || res.Task.StatusChangedAt == nil {
+			t.Errorf("expected status change to be recorded, got %v", res.Task)
+		}
+
+		listRes, err := taskService.ListTask(testUserContext(), &task.ListTaskRequest{Filter: &task.TaskFilter{Status: "in_progress"}})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(listRes.Tasks) != 1 || listRes.Tasks[0].Id != taskID {
+			t.Errorf("expected only the in_progress task, got %v", listRes.Tasks)
+		}
+	})
+
+	t.Run("異常系_許可されていない遷移", func(t *testing.T) {
+		if _, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: taskID, Status: "cancelled"}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		// cancelledからはtodoにしか戻せない
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: taskID, Status: "done"})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_存在しないステータス", func(t *testing.T) {
+		_, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: taskID, Status: "review"})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	createRes, err := projectService.CreateProject(testUserContext(), &task.CreateProjectRequest{Name: "Workflow"})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	projectID := createRes.Id
+	projectTaskRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+		Title:     "プロジェクトのタスク",
+		LimitedAt: timestamppb.Now(),
+		ProjectId: projectID,
+	})
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	t.Run("正常系_既定のワークフロー", func(t *testing.T) {
+		res, err := projectService.GetWorkflow(testUserContext(), &task.GetWorkflowRequest{ProjectId: projectID})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(res.Workflow.Statuses) != 4 || res.Workflow.Statuses[0].Name != "todo" || len(res.Workflow.Transitions) != 8 {
+			t.Errorf("expected default workflow, got %v", res.Workflow)
+		}
+	})
+
+	t.Run("異常系_タスクが残るステータスの削除", func(t *testing.T) {
+		_, err := projectService.UpdateWorkflow(testUserContext(), &task.UpdateWorkflowRequest{
+			ProjectId: projectID,
+			Workflow: &task.Workflow{
+				Statuses: []*task.WorkflowStatus{{Name: "backlog"}, {Name: "shipped", IsEnd: true}},
+			},
+		})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("異常系_ワークフローにないステータスへの遷移", func(t *testing.T) {
+		_, err := projectService.UpdateWorkflow(testUserContext(), &task.UpdateWorkflowRequest{
+			ProjectId: projectID,
+			Workflow: &task.Workflow{
+				Statuses:    []*task.WorkflowStatus{{Name: "todo"}},
+				Transitions: []*task.WorkflowTransition{{FromStatus: "todo", ToStatus: "review"}},
+			},
+		})
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_ワークフローの変更", func(t *testing.T) {
+		_, err := projectService.UpdateWorkflow(testUserContext(), &task.UpdateWorkflowRequest{
+			ProjectId: projectID,
+			Workflow: &task.Workflow{
+				Statuses: []*task.WorkflowStatus{{Name: "todo"}, {Name: "review"}, {Name: "shipped", IsEnd: true}},
+				Transitions: []*task.WorkflowTransition{
+					{FromStatus: "todo", ToStatus: "review"},
+					{FromStatus: "review", ToStatus: "shipped"},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		for _, status := range []string{"review", "shipped"} {
+			if _, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: projectTaskRes.Id, Status: status}); err != nil {
+				t.Fatalf("expected no error for %s, got %v", status, err)
+			}
+		}
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: projectTaskRes.Id})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Task.Status != "shipped" || !res.Task.IsEnd {
+			t.Errorf("expected shipped task, got %v", res.Task)
+		}
+
+		// 個人タスクのワークフローは変わらない
+		_, err = taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: taskID, Status: "review"})
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("expected failed precondition error, got %v", err)
+		}
+	})
+
+	t.Run("正常系_終了扱いになったステータスのタスクは完了として扱う", func(t *testing.T) {
+		recurringRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:      "繰り返しのタスク",
+			LimitedAt:  timestamppb.Now(),
+			ProjectId:  projectID,
+			Recurrence: "RRULE:FREQ=DAILY",
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		if _, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: recurringRes.Id, Status: "review"}); err != nil {
+			t.Fatalf("failed to transition task: %v", err)
+		}
+
+		_, err = projectService.UpdateWorkflow(testUserContext(), &task.UpdateWorkflowRequest{
+			ProjectId: projectID,
+			Workflow: &task.Workflow{
+				Statuses:    []*task.WorkflowStatus{{Name: "todo"}, {Name: "review", IsEnd: true}, {Name: "shipped", IsEnd: true}},
+				Transitions: []*task.WorkflowTransition{{FromStatus: "todo", ToStatus: "review"}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: recurringRes.Id})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !res.Task.IsEnd {
+			t.Errorf("expected task to be done, got %v", res.Task)
+		}
+		historyRes, err := taskService.ListTaskHistory(testUserContext(), &task.ListTaskHistoryRequest{Id: recurringRes.Id, Limit: 1})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		c := historyRes.History[0].Changes
+		if len(c) != 1 || c[0].Field != "is_end" || c[0].Before != "false" || c[0].After != "true" {
+			t.Errorf("unexpected changes: %v", c)
+		}
+		var next int
+		if err := db.QueryRow(`SELECT count(*) FROM task WHERE previous_id = $1`, recurringRes.Id).Scan(&next); err != nil {
+			t.Fatalf("failed to count tasks: %v", err)
+		}
+		if next != 1 {
+			t.Errorf("expected the next occurrence to be created, got %d", next)
+		}
+	})
+
+	t.Run("正常系_ゴミ箱のタスクは復元したときに反映する", func(t *testing.T) {
+		trashedRes, err := taskService.CreateTask(testUserContext(), &task.CreateTaskRequest{
+			Title:     "ゴミ箱のタスク",
+			LimitedAt: timestamppb.Now(),
+			ProjectId: projectID,
+		})
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		if _, err := taskService.TransitionTask(testUserContext(), &task.TransitionTaskRequest{Id: trashedRes.Id, Status: "review"}); err != nil {
+			t.Fatalf("failed to transition task: %v", err)
+		}
+		if _, err := taskService.DeleteTask(testUserContext(), &task.DeleteTaskRequest{Id: trashedRes.Id}); err != nil {
+			t.Fatalf("failed to delete task: %v", err)
+		}
+
+		_, err = projectService.UpdateWorkflow(testUserContext(), &task.UpdateWorkflowRequest{
+			ProjectId: projectID,
+			Workflow: &task.Workflow{
+				Statuses:    []*task.WorkflowStatus{{Name: "todo"}, {Name: "review"}, {Name: "shipped", IsEnd: true}},
+				Transitions: []*task.WorkflowTransition{{FromStatus: "todo", ToStatus: "review"}, {FromStatus: "review", ToStatus: "shipped"}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		var isEnd bool
+		if err := db.QueryRow(`SELECT is_end FROM task WHERE id = $1`, trashedRes.Id).Scan(&isEnd); err != nil {
+			t.Fatalf("failed to get task: %v", err)
+		}
+		if !isEnd {
+			t.Errorf("expected task in the trash to be left as it is")
+		}
+
+		if _, err := taskService.RestoreTask(testUserContext(), &task.RestoreTaskRequest{Id: trashedRes.Id}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		res, err := taskService.GetTask(testUserContext(), &task.GetTaskRequest{Id: trashedRes.Id})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if res.Task.IsEnd {
+			t.Errorf("expected restored task to be reopened, got %v", res.Task)
+		}
+		historyRes, err := taskService.ListTaskHistory(testUserContext(), &task.ListTaskHistoryRequest{Id: trashedRes.Id, Limit: 1})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		c := historyRes.History[0].Changes
+		if len(c) != 1 || c[0].Field != "is_end" || c[0].Before != "true" || c[0].After != "false" {
+			t.Errorf("unexpected changes: %v", c)
+		}
+	})
+}
@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/v1/api.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sikigasa/task-controller/proto/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TaskServiceName is the fully-qualified name of the TaskService service.
+	TaskServiceName = "proto.v1.TaskService"
+	// TagServiceName is the fully-qualified name of the TagService service.
+	TagServiceName = "proto.v1.TagService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TaskServiceCreateTaskProcedure is the fully-qualified name of the TaskService's CreateTask RPC.
+	TaskServiceCreateTaskProcedure = "/proto.v1.TaskService/CreateTask"
+	// TaskServiceGetTaskProcedure is the fully-qualified name of the TaskService's GetTask RPC.
+	TaskServiceGetTaskProcedure = "/proto.v1.TaskService/GetTask"
+	// TaskServiceListTaskProcedure is the fully-qualified name of the TaskService's ListTask RPC.
+	TaskServiceListTaskProcedure = "/proto.v1.TaskService/ListTask"
+	// TaskServiceUpdateTaskProcedure is the fully-qualified name of the TaskService's UpdateTask RPC.
+	TaskServiceUpdateTaskProcedure = "/proto.v1.TaskService/UpdateTask"
+	// TaskServiceDeleteTaskProcedure is the fully-qualified name of the TaskService's DeleteTask RPC.
+	TaskServiceDeleteTaskProcedure = "/proto.v1.TaskService/DeleteTask"
+	// TaskServiceListTrashProcedure is the fully-qualified name of the TaskService's ListTrash RPC.
+	TaskServiceListTrashProcedure = "/proto.v1.TaskService/ListTrash"
+	// TaskServiceRestoreTaskProcedure is the fully-qualified name of the TaskService's RestoreTask RPC.
+	TaskServiceRestoreTaskProcedure = "/proto.v1.TaskService/RestoreTask"
+	// TaskServicePurgeTaskProcedure is the fully-qualified name of the TaskService's PurgeTask RPC.
+	TaskServicePurgeTaskProcedure = "/proto.v1.TaskService/PurgeTask"
+	// TaskServiceListSubtasksProcedure is the fully-qualified name of the TaskService's ListSubtasks
+	// RPC.
+	TaskServiceListSubtasksProcedure = "/proto.v1.TaskService/ListSubtasks"
+	// TaskServiceTransitionTaskProcedure is the fully-qualified name of the TaskService's
+	// TransitionTask RPC.
+	TaskServiceTransitionTaskProcedure = "/proto.v1.TaskService/TransitionTask"
+	// TaskServiceMoveTaskProcedure is the fully-qualified name of the TaskService's MoveTask RPC.
+	TaskServiceMoveTaskProcedure = "/proto.v1.TaskService/MoveTask"
+	// TaskServiceAddDependencyProcedure is the fully-qualified name of the TaskService's AddDependency
+	// RPC.
+	TaskServiceAddDependencyProcedure = "/proto.v1.TaskService/AddDependency"
+	// TaskServiceRemoveDependencyProcedure is the fully-qualified name of the TaskService's
+	// RemoveDependency RPC.
+	TaskServiceRemoveDependencyProcedure = "/proto.v1.TaskService/RemoveDependency"
+	// TaskServiceListTaskHistoryProcedure is the fully-qualified name of the TaskService's
+	// ListTaskHistory RPC.
+	TaskServiceListTaskHistoryProcedure = "/proto.v1.TaskService/ListTaskHistory"
+	// TaskServiceWatchTasksProcedure is the fully-qualified name of the TaskService's WatchTasks RPC.
+	TaskServiceWatchTasksProcedure = "/proto.v1.TaskService/WatchTasks"
+	// TagServiceCreateTagProcedure is the fully-qualified name of the TagService's CreateTag RPC.
+	TagServiceCreateTagProcedure = "/proto.v1.TagService/CreateTag"
+	// TagServiceGetTagProcedure is the fully-qualified name of the TagService's GetTag RPC.
+	TagServiceGetTagProcedure = "/proto.v1.TagService/GetTag"
+	// TagServiceListTagProcedure is the fully-qualified name of the TagService's ListTag RPC.
+	TagServiceListTagProcedure = "/proto.v1.TagService/ListTag"
+	// TagServiceUpdateTagProcedure is the fully-qualified name of the TagService's UpdateTag RPC.
+	TagServiceUpdateTagProcedure = "/proto.v1.TagService/UpdateTag"
+	// TagServiceDeleteTagProcedure is the fully-qualified name of the TagService's DeleteTag RPC.
+	TagServiceDeleteTagProcedure = "/proto.v1.TagService/DeleteTag"
+)
+
+// TaskServiceClient is a client for the proto.v1.TaskService service.
+type TaskServiceClient interface {
+	// Create a new task.
+	CreateTask(context.Context, *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error)
+	// Read a task by ID.
+	GetTask(context.Context, *v1.GetTaskRequest) (*v1.GetTaskResponse, error)
+	// List tasks matching filter in order_by order, paged by page_token (or limit and offset).
+	ListTask(context.Context, *v1.ListTaskRequest) (*v1.ListTaskResponse, error)
+	// Update an existing task.
+	UpdateTask(context.Context, *v1.UpdateTaskRequest) (*v1.UpdateTaskResponse, error)
+	// Move a task and its subtasks to the trash. Tasks in the trash are
+	// permanently deleted once the retention period has passed.
+	DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error)
+	// List the tasks in the trash, most recently deleted first.
+	ListTrash(context.Context, *v1.ListTrashRequest) (*v1.ListTrashResponse, error)
+	// Take a task out of the trash together with the subtasks deleted with it.
+	// Tasks whose status became an end status or stopped being one meanwhile
+	// are completed or reopened.
+	RestoreTask(context.Context, *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error)
+	// Permanently delete a task in the trash and its subtasks.
+	PurgeTask(context.Context, *v1.PurgeTaskRequest) (*v1.PurgeTaskResponse, error)
+	// List the direct subtasks of a task, paged like ListTask.
+	ListSubtasks(context.Context, *v1.ListSubtasksRequest) (*v1.ListSubtasksResponse, error)
+	// Move a task to another status of its workflow. The change must be one of
+	// the transitions of the workflow.
+	TransitionTask(context.Context, *v1.TransitionTaskRequest) (*v1.TransitionTaskResponse, error)
+	// Place a task between two neighbors in the manual order. Only the moved
+	// task changes, so the rest of the list keeps its positions.
+	MoveTask(context.Context, *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error)
+	// Mark a task as blocked by another task. A task cannot end up blocked by
+	// itself through a chain of dependencies.
+	AddDependency(context.Context, *v1.AddDependencyRequest) (*v1.AddDependencyResponse, error)
+	// Remove a blocked-by relationship.
+	RemoveDependency(context.Context, *v1.RemoveDependencyRequest) (*v1.RemoveDependencyResponse, error)
+	// List the changes made to a task, newest first.
+	ListTaskHistory(context.Context, *v1.ListTaskHistoryRequest) (*v1.ListTaskHistoryResponse, error)
+	// Stream task changes made by any server as they happen.
+	WatchTasks(context.Context, *v1.WatchTasksRequest) (*connect.ServerStreamForClient[v1.WatchTasksResponse], error)
+}
+
+// NewTaskServiceClient constructs a client for the proto.v1.TaskService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TaskServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	taskServiceMethods := v1.File_proto_v1_api_proto.Services().ByName("TaskService").Methods()
+	return &taskServiceClient{
+		createTask: connect.NewClient[v1.CreateTaskRequest, v1.CreateTaskResponse](
+			httpClient,
+			baseURL+TaskServiceCreateTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("CreateTask")),
+			connect.WithClientOptions(opts...),
+		),
+		getTask: connect.NewClient[v1.GetTaskRequest, v1.GetTaskResponse](
+			httpClient,
+			baseURL+TaskServiceGetTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("GetTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listTask: connect.NewClient[v1.ListTaskRequest, v1.ListTaskResponse](
+			httpClient,
+			baseURL+TaskServiceListTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ListTask")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTask: connect.NewClient[v1.UpdateTaskRequest, v1.UpdateTaskResponse](
+			httpClient,
+			baseURL+TaskServiceUpdateTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("UpdateTask")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTask: connect.NewClient[v1.DeleteTaskRequest, v1.DeleteTaskResponse](
+			httpClient,
+			baseURL+TaskServiceDeleteTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("DeleteTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listTrash: connect.NewClient[v1.ListTrashRequest, v1.ListTrashResponse](
+			httpClient,
+			baseURL+TaskServiceListTrashProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ListTrash")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreTask: connect.NewClient[v1.RestoreTaskRequest, v1.RestoreTaskResponse](
+			httpClient,
+			baseURL+TaskServiceRestoreTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("RestoreTask")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeTask: connect.NewClient[v1.PurgeTaskRequest, v1.PurgeTaskResponse](
+			httpClient,
+			baseURL+TaskServicePurgeTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("PurgeTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listSubtasks: connect.NewClient[v1.ListSubtasksRequest, v1.ListSubtasksResponse](
+			httpClient,
+			baseURL+TaskServiceListSubtasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ListSubtasks")),
+			connect.WithClientOptions(opts...),
+		),
+		transitionTask: connect.NewClient[v1.TransitionTaskRequest, v1.TransitionTaskResponse](
+			httpClient,
+			baseURL+TaskServiceTransitionTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("TransitionTask")),
+			connect.WithClientOptions(opts...),
+		),
+		moveTask: connect.NewClient[v1.MoveTaskRequest, v1.MoveTaskResponse](
+			httpClient,
+			baseURL+TaskServiceMoveTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("MoveTask")),
+			connect.WithClientOptions(opts...),
+		),
+		addDependency: connect.NewClient[v1.AddDependencyRequest, v1.AddDependencyResponse](
+			httpClient,
+			baseURL+TaskServiceAddDependencyProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("AddDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		removeDependency: connect.NewClient[v1.RemoveDependencyRequest, v1.RemoveDependencyResponse](
+			httpClient,
+			baseURL+TaskServiceRemoveDependencyProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("RemoveDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		listTaskHistory: connect.NewClient[v1.ListTaskHistoryRequest, v1.ListTaskHistoryResponse](
+			httpClient,
+			baseURL+TaskServiceListTaskHistoryProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ListTaskHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		watchTasks: connect.NewClient[v1.WatchTasksRequest, v1.WatchTasksResponse](
+			httpClient,
+			baseURL+TaskServiceWatchTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("WatchTasks")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// taskServiceClient implements TaskServiceClient.
+type taskServiceClient struct {
+	createTask       *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask          *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	listTask         *connect.Client[v1.ListTaskRequest, v1.ListTaskResponse]
+	updateTask       *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	deleteTask       *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	listTrash        *connect.Client[v1.ListTrashRequest, v1.ListTrashResponse]
+	restoreTask      *connect.Client[v1.RestoreTaskRequest, v1.RestoreTaskResponse]
+	purgeTask        *connect.Client[v1.PurgeTaskRequest, v1.PurgeTaskResponse]
+	listSubtasks     *connect.Client[v1.ListSubtasksRequest, v1.ListSubtasksResponse]
+	transitionTask   *connect.Client[v1.TransitionTaskRequest, v1.TransitionTaskResponse]
+	moveTask         *connect.Client[v1.MoveTaskRequest, v1.MoveTaskResponse]
+	addDependency    *connect.Client[v1.AddDependencyRequest, v1.AddDependencyResponse]
+	removeDependency *connect.Client[v1.RemoveDependencyRequest, v1.RemoveDependencyResponse]
+	listTaskHistory  *connect.Client[v1.ListTaskHistoryRequest, v1.ListTaskHistoryResponse]
+	watchTasks       *connect.Client[v1.WatchTasksRequest, v1.WatchTasksResponse]
+}
+
+// CreateTask calls proto.v1.TaskService.CreateTask.
+func (c *taskServiceClient) CreateTask(ctx context.Context, req *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error) {
+	response, err := c.createTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTask calls proto.v1.TaskService.GetTask.
+func (c *taskServiceClient) GetTask(ctx context.Context, req *v1.GetTaskRequest) (*v1.GetTaskResponse, error) {
+	response, err := c.getTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTask calls proto.v1.TaskService.ListTask.
+func (c *taskServiceClient) ListTask(ctx context.Context, req *v1.ListTaskRequest) (*v1.ListTaskResponse, error) {
+	response, err := c.listTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateTask calls proto.v1.TaskService.UpdateTask.
+func (c *taskServiceClient) UpdateTask(ctx context.Context, req *v1.UpdateTaskRequest) (*v1.UpdateTaskResponse, error) {
+	response, err := c.updateTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTask calls proto.v1.TaskService.DeleteTask.
+func (c *taskServiceClient) DeleteTask(ctx context.Context, req *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error) {
+	response, err := c.deleteTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTrash calls proto.v1.TaskService.ListTrash.
+func (c *taskServiceClient) ListTrash(ctx context.Context, req *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	response, err := c.listTrash.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreTask calls proto.v1.TaskService.RestoreTask.
+func (c *taskServiceClient) RestoreTask(ctx context.Context, req *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error) {
+	response, err := c.restoreTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PurgeTask calls proto.v1.TaskService.PurgeTask.
+func (c *taskServiceClient) PurgeTask(ctx context.Context, req *v1.PurgeTaskRequest) (*v1.PurgeTaskResponse, error) {
+	response, err := c.purgeTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListSubtasks calls proto.v1.TaskService.ListSubtasks.
+func (c *taskServiceClient) ListSubtasks(ctx context.Context, req *v1.ListSubtasksRequest) (*v1.ListSubtasksResponse, error) {
+	response, err := c.listSubtasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TransitionTask calls proto.v1.TaskService.TransitionTask.
+func (c *taskServiceClient) TransitionTask(ctx context.Context, req *v1.TransitionTaskRequest) (*v1.TransitionTaskResponse, error) {
+	response, err := c.transitionTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MoveTask calls proto.v1.TaskService.MoveTask.
+func (c *taskServiceClient) MoveTask(ctx context.Context, req *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error) {
+	response, err := c.moveTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddDependency calls proto.v1.TaskService.AddDependency.
+func (c *taskServiceClient) AddDependency(ctx context.Context, req *v1.AddDependencyRequest) (*v1.AddDependencyResponse, error) {
+	response, err := c.addDependency.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RemoveDependency calls proto.v1.TaskService.RemoveDependency.
+func (c *taskServiceClient) RemoveDependency(ctx context.Context, req *v1.RemoveDependencyRequest) (*v1.RemoveDependencyResponse, error) {
+	response, err := c.removeDependency.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTaskHistory calls proto.v1.TaskService.ListTaskHistory.
+func (c *taskServiceClient) ListTaskHistory(ctx context.Context, req *v1.ListTaskHistoryRequest) (*v1.ListTaskHistoryResponse, error) {
+	response, err := c.listTaskHistory.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WatchTasks calls proto.v1.TaskService.WatchTasks.
+func (c *taskServiceClient) WatchTasks(ctx context.Context, req *v1.WatchTasksRequest) (*connect.ServerStreamForClient[v1.WatchTasksResponse], error) {
+	return c.watchTasks.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// TaskServiceHandler is an implementation of the proto.v1.TaskService service.
+type TaskServiceHandler interface {
+	// Create a new task.
+	CreateTask(context.Context, *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error)
+	// Read a task by ID.
+	GetTask(context.Context, *v1.GetTaskRequest) (*v1.GetTaskResponse, error)
+	// List tasks matching filter in order_by order, paged by page_token (or limit and offset).
+	ListTask(context.Context, *v1.ListTaskRequest) (*v1.ListTaskResponse, error)
+	// Update an existing task.
+	UpdateTask(context.Context, *v1.UpdateTaskRequest) (*v1.UpdateTaskResponse, error)
+	// Move a task and its subtasks to the trash. Tasks in the trash are
+	// permanently deleted once the retention period has passed.
+	DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error)
+	// List the tasks in the trash, most recently deleted first.
+	ListTrash(context.Context, *v1.ListTrashRequest) (*v1.ListTrashResponse, error)
+	// Take a task out of the trash together with the subtasks deleted with it.
+	// Tasks whose status became an end status or stopped being one meanwhile
+	// are completed or reopened.
+	RestoreTask(context.Context, *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error)
+	// Permanently delete a task in the trash and its subtasks.
+	PurgeTask(context.Context, *v1.PurgeTaskRequest) (*v1.PurgeTaskResponse, error)
+	// List the direct subtasks of a task, paged like ListTask.
+	ListSubtasks(context.Context, *v1.ListSubtasksRequest) (*v1.ListSubtasksResponse, error)
+	// Move a task to another status of its workflow. The change must be one of
+	// the transitions of the workflow.
+	TransitionTask(context.Context, *v1.TransitionTaskRequest) (*v1.TransitionTaskResponse, error)
+	// Place a task between two neighbors in the manual order. Only the moved
+	// task changes, so the rest of the list keeps its positions.
+	MoveTask(context.Context, *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error)
+	// Mark a task as blocked by another task. A task cannot end up blocked by
+	// itself through a chain of dependencies.
+	AddDependency(context.Context, *v1.AddDependencyRequest) (*v1.AddDependencyResponse, error)
+	// Remove a blocked-by relationship.
+	RemoveDependency(context.Context, *v1.RemoveDependencyRequest) (*v1.RemoveDependencyResponse, error)
+	// List the changes made to a task, newest first.
+	ListTaskHistory(context.Context, *v1.ListTaskHistoryRequest) (*v1.ListTaskHistoryResponse, error)
+	// Stream task changes made by any server as they happen.
+	WatchTasks(context.Context, *v1.WatchTasksRequest, *connect.ServerStream[v1.WatchTasksResponse]) error
+}
+
+// NewTaskServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	taskServiceMethods := v1.File_proto_v1_api_proto.Services().ByName("TaskService").Methods()
+	taskServiceCreateTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceCreateTaskProcedure,
+		svc.CreateTask,
+		connect.WithSchema(taskServiceMethods.ByName("CreateTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceGetTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceGetTaskProcedure,
+		svc.GetTask,
+		connect.WithSchema(taskServiceMethods.ByName("GetTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceListTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceListTaskProcedure,
+		svc.ListTask,
+		connect.WithSchema(taskServiceMethods.ByName("ListTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceUpdateTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceUpdateTaskProcedure,
+		svc.UpdateTask,
+		connect.WithSchema(taskServiceMethods.ByName("UpdateTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceDeleteTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceDeleteTaskProcedure,
+		svc.DeleteTask,
+		connect.WithSchema(taskServiceMethods.ByName("DeleteTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceListTrashHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceListTrashProcedure,
+		svc.ListTrash,
+		connect.WithSchema(taskServiceMethods.ByName("ListTrash")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceRestoreTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceRestoreTaskProcedure,
+		svc.RestoreTask,
+		connect.WithSchema(taskServiceMethods.ByName("RestoreTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServicePurgeTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServicePurgeTaskProcedure,
+		svc.PurgeTask,
+		connect.WithSchema(taskServiceMethods.ByName("PurgeTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceListSubtasksHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceListSubtasksProcedure,
+		svc.ListSubtasks,
+		connect.WithSchema(taskServiceMethods.ByName("ListSubtasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceTransitionTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceTransitionTaskProcedure,
+		svc.TransitionTask,
+		connect.WithSchema(taskServiceMethods.ByName("TransitionTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceMoveTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceMoveTaskProcedure,
+		svc.MoveTask,
+		connect.WithSchema(taskServiceMethods.ByName("MoveTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceAddDependencyHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceAddDependencyProcedure,
+		svc.AddDependency,
+		connect.WithSchema(taskServiceMethods.ByName("AddDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceRemoveDependencyHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceRemoveDependencyProcedure,
+		svc.RemoveDependency,
+		connect.WithSchema(taskServiceMethods.ByName("RemoveDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceListTaskHistoryHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceListTaskHistoryProcedure,
+		svc.ListTaskHistory,
+		connect.WithSchema(taskServiceMethods.ByName("ListTaskHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceWatchTasksHandler := connect.NewServerStreamHandlerSimple(
+		TaskServiceWatchTasksProcedure,
+		svc.WatchTasks,
+		connect.WithSchema(taskServiceMethods.ByName("WatchTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.v1.TaskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TaskServiceCreateTaskProcedure:
+			taskServiceCreateTaskHandler.ServeHTTP(w, r)
+		case TaskServiceGetTaskProcedure:
+			taskServiceGetTaskHandler.ServeHTTP(w, r)
+		case TaskServiceListTaskProcedure:
+			taskServiceListTaskHandler.ServeHTTP(w, r)
+		case TaskServiceUpdateTaskProcedure:
+			taskServiceUpdateTaskHandler.ServeHTTP(w, r)
+		case TaskServiceDeleteTaskProcedure:
+			taskServiceDeleteTaskHandler.ServeHTTP(w, r)
+		case TaskServiceListTrashProcedure:
+			taskServiceListTrashHandler.ServeHTTP(w, r)
+		case TaskServiceRestoreTaskProcedure:
+			taskServiceRestoreTaskHandler.ServeHTTP(w, r)
+		case TaskServicePurgeTaskProcedure:
+			taskServicePurgeTaskHandler.ServeHTTP(w, r)
+		case TaskServiceListSubtasksProcedure:
+			taskServiceListSubtasksHandler.ServeHTTP(w, r)
+		case TaskServiceTransitionTaskProcedure:
+			taskServiceTransitionTaskHandler.ServeHTTP(w, r)
+		case TaskServiceMoveTaskProcedure:
+			taskServiceMoveTaskHandler.ServeHTTP(w, r)
+		case TaskServiceAddDependencyProcedure:
+			taskServiceAddDependencyHandler.ServeHTTP(w, r)
+		case TaskServiceRemoveDependencyProcedure:
+			taskServiceRemoveDependencyHandler.ServeHTTP(w, r)
+		case TaskServiceListTaskHistoryProcedure:
+			taskServiceListTaskHistoryHandler.ServeHTTP(w, r)
+		case TaskServiceWatchTasksProcedure:
+			taskServiceWatchTasksHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTaskServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTaskServiceHandler struct{}
+
+func (UnimplementedTaskServiceHandler) CreateTask(context.Context, *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.CreateTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) GetTask(context.Context, *v1.GetTaskRequest) (*v1.GetTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.GetTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ListTask(context.Context, *v1.ListTaskRequest) (*v1.ListTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.ListTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) UpdateTask(context.Context, *v1.UpdateTaskRequest) (*v1.UpdateTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.UpdateTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.DeleteTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ListTrash(context.Context, *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.ListTrash is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) RestoreTask(context.Context, *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.RestoreTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) PurgeTask(context.Context, *v1.PurgeTaskRequest) (*v1.PurgeTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.PurgeTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ListSubtasks(context.Context, *v1.ListSubtasksRequest) (*v1.ListSubtasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.ListSubtasks is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) TransitionTask(context.Context, *v1.TransitionTaskRequest) (*v1.TransitionTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.TransitionTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) MoveTask(context.Context, *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.MoveTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) AddDependency(context.Context, *v1.AddDependencyRequest) (*v1.AddDependencyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.AddDependency is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) RemoveDependency(context.Context, *v1.RemoveDependencyRequest) (*v1.RemoveDependencyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.RemoveDependency is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ListTaskHistory(context.Context, *v1.ListTaskHistoryRequest) (*v1.ListTaskHistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.ListTaskHistory is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) WatchTasks(context.Context, *v1.WatchTasksRequest, *connect.ServerStream[v1.WatchTasksResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TaskService.WatchTasks is not implemented"))
+}
+
+// TagServiceClient is a client for the proto.v1.TagService service.
+type TagServiceClient interface {
+	// Create a new tag.
+	CreateTag(context.Context, *v1.CreateTagRequest) (*v1.CreateTagResponse, error)
+	// Read a tag by ID.
+	GetTag(context.Context, *v1.GetTagRequest) (*v1.GetTagResponse, error)
+	// List tags ordered by creation, paged by page_token (or limit and offset).
+	ListTag(context.Context, *v1.ListTagRequest) (*v1.ListTagResponse, error)
+	// Rename a tag. Tag names are unique regardless of case within the personal
+	// tags of a user or the tags of a project.
+	UpdateTag(context.Context, *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error)
+	// Delete a tag by ID.
+	DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error)
+}
+
+// NewTagServiceClient constructs a client for the proto.v1.TagService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TagServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tagServiceMethods := v1.File_proto_v1_api_proto.Services().ByName("TagService").Methods()
+	return &tagServiceClient{
+		createTag: connect.NewClient[v1.CreateTagRequest, v1.CreateTagResponse](
+			httpClient,
+			baseURL+TagServiceCreateTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("CreateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		getTag: connect.NewClient[v1.GetTagRequest, v1.GetTagResponse](
+			httpClient,
+			baseURL+TagServiceGetTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("GetTag")),
+			connect.WithClientOptions(opts...),
+		),
+		listTag: connect.NewClient[v1.ListTagRequest, v1.ListTagResponse](
+			httpClient,
+			baseURL+TagServiceListTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("ListTag")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTag: connect.NewClient[v1.UpdateTagRequest, v1.UpdateTagResponse](
+			httpClient,
+			baseURL+TagServiceUpdateTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("UpdateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTag: connect.NewClient[v1.DeleteTagRequest, v1.DeleteTagResponse](
+			httpClient,
+			baseURL+TagServiceDeleteTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tagServiceClient implements TagServiceClient.
+type tagServiceClient struct {
+	createTag *connect.Client[v1.CreateTagRequest, v1.CreateTagResponse]
+	getTag    *connect.Client[v1.GetTagRequest, v1.GetTagResponse]
+	listTag   *connect.Client[v1.ListTagRequest, v1.ListTagResponse]
+	updateTag *connect.Client[v1.UpdateTagRequest, v1.UpdateTagResponse]
+	deleteTag *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+}
+
+// CreateTag calls proto.v1.TagService.CreateTag.
+func (c *tagServiceClient) CreateTag(ctx context.Context, req *v1.CreateTagRequest) (*v1.CreateTagResponse, error) {
+	response, err := c.createTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTag calls proto.v1.TagService.GetTag.
+func (c *tagServiceClient) GetTag(ctx context.Context, req *v1.GetTagRequest) (*v1.GetTagResponse, error) {
+	response, err := c.getTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTag calls proto.v1.TagService.ListTag.
+func (c *tagServiceClient) ListTag(ctx context.Context, req *v1.ListTagRequest) (*v1.ListTagResponse, error) {
+	response, err := c.listTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateTag calls proto.v1.TagService.UpdateTag.
+func (c *tagServiceClient) UpdateTag(ctx context.Context, req *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error) {
+	response, err := c.updateTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTag calls proto.v1.TagService.DeleteTag.
+func (c *tagServiceClient) DeleteTag(ctx context.Context, req *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	response, err := c.deleteTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TagServiceHandler is an implementation of the proto.v1.TagService service.
+type TagServiceHandler interface {
+	// Create a new tag.
+	CreateTag(context.Context, *v1.CreateTagRequest) (*v1.CreateTagResponse, error)
+	// Read a tag by ID.
+	GetTag(context.Context, *v1.GetTagRequest) (*v1.GetTagResponse, error)
+	// List tags ordered by creation, paged by page_token (or limit and offset).
+	ListTag(context.Context, *v1.ListTagRequest) (*v1.ListTagResponse, error)
+	// Rename a tag. Tag names are unique regardless of case within the personal
+	// tags of a user or the tags of a project.
+	UpdateTag(context.Context, *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error)
+	// Delete a tag by ID.
+	DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error)
+}
+
+// NewTagServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTagServiceHandler(svc TagServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tagServiceMethods := v1.File_proto_v1_api_proto.Services().ByName("TagService").Methods()
+	tagServiceCreateTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceCreateTagProcedure,
+		svc.CreateTag,
+		connect.WithSchema(tagServiceMethods.ByName("CreateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceGetTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceGetTagProcedure,
+		svc.GetTag,
+		connect.WithSchema(tagServiceMethods.ByName("GetTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceListTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceListTagProcedure,
+		svc.ListTag,
+		connect.WithSchema(tagServiceMethods.ByName("ListTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceUpdateTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceUpdateTagProcedure,
+		svc.UpdateTag,
+		connect.WithSchema(tagServiceMethods.ByName("UpdateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceDeleteTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceDeleteTagProcedure,
+		svc.DeleteTag,
+		connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.v1.TagService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TagServiceCreateTagProcedure:
+			tagServiceCreateTagHandler.ServeHTTP(w, r)
+		case TagServiceGetTagProcedure:
+			tagServiceGetTagHandler.ServeHTTP(w, r)
+		case TagServiceListTagProcedure:
+			tagServiceListTagHandler.ServeHTTP(w, r)
+		case TagServiceUpdateTagProcedure:
+			tagServiceUpdateTagHandler.ServeHTTP(w, r)
+		case TagServiceDeleteTagProcedure:
+			tagServiceDeleteTagHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTagServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTagServiceHandler struct{}
+
+func (UnimplementedTagServiceHandler) CreateTag(context.Context, *v1.CreateTagRequest) (*v1.CreateTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TagService.CreateTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) GetTag(context.Context, *v1.GetTagRequest) (*v1.GetTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TagService.GetTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) ListTag(context.Context, *v1.ListTagRequest) (*v1.ListTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TagService.ListTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) UpdateTag(context.Context, *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TagService.UpdateTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.TagService.DeleteTag is not implemented"))
+}
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/v1/attachment.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sikigasa/task-controller/proto/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AttachmentServiceName is the fully-qualified name of the AttachmentService service.
+	AttachmentServiceName = "proto.v1.AttachmentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AttachmentServiceCreateAttachmentProcedure is the fully-qualified name of the AttachmentService's
+	// CreateAttachment RPC.
+	AttachmentServiceCreateAttachmentProcedure = "/proto.v1.AttachmentService/CreateAttachment"
+	// AttachmentServiceListAttachmentsProcedure is the fully-qualified name of the AttachmentService's
+	// ListAttachments RPC.
+	AttachmentServiceListAttachmentsProcedure = "/proto.v1.AttachmentService/ListAttachments"
+	// AttachmentServiceGetAttachmentProcedure is the fully-qualified name of the AttachmentService's
+	// GetAttachment RPC.
+	AttachmentServiceGetAttachmentProcedure = "/proto.v1.AttachmentService/GetAttachment"
+	// AttachmentServiceDeleteAttachmentProcedure is the fully-qualified name of the AttachmentService's
+	// DeleteAttachment RPC.
+	AttachmentServiceDeleteAttachmentProcedure = "/proto.v1.AttachmentService/DeleteAttachment"
+)
+
+// AttachmentServiceClient is a client for the proto.v1.AttachmentService service.
+type AttachmentServiceClient interface {
+	// Add an attachment to a task and return the URL to upload its contents
+	// to. Requires the editor role for project tasks.
+	CreateAttachment(context.Context, *v1.CreateAttachmentRequest) (*v1.CreateAttachmentResponse, error)
+	// List the attachments of a task, oldest first.
+	ListAttachments(context.Context, *v1.ListAttachmentsRequest) (*v1.ListAttachmentsResponse, error)
+	// Get an attachment with the URL to download its contents from.
+	GetAttachment(context.Context, *v1.GetAttachmentRequest) (*v1.GetAttachmentResponse, error)
+	// Delete an attachment and its contents. Requires the editor role for
+	// project tasks.
+	DeleteAttachment(context.Context, *v1.DeleteAttachmentRequest) (*v1.DeleteAttachmentResponse, error)
+}
+
+// NewAttachmentServiceClient constructs a client for the proto.v1.AttachmentService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAttachmentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AttachmentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	attachmentServiceMethods := v1.File_proto_v1_attachment_proto.Services().ByName("AttachmentService").Methods()
+	return &attachmentServiceClient{
+		createAttachment: connect.NewClient[v1.CreateAttachmentRequest, v1.CreateAttachmentResponse](
+			httpClient,
+			baseURL+AttachmentServiceCreateAttachmentProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("CreateAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		listAttachments: connect.NewClient[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse](
+			httpClient,
+			baseURL+AttachmentServiceListAttachmentsProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("ListAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		getAttachment: connect.NewClient[v1.GetAttachmentRequest, v1.GetAttachmentResponse](
+			httpClient,
+			baseURL+AttachmentServiceGetAttachmentProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("GetAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAttachment: connect.NewClient[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse](
+			httpClient,
+			baseURL+AttachmentServiceDeleteAttachmentProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("DeleteAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// attachmentServiceClient implements AttachmentServiceClient.
+type attachmentServiceClient struct {
+	createAttachment *connect.Client[v1.CreateAttachmentRequest, v1.CreateAttachmentResponse]
+	listAttachments  *connect.Client[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse]
+	getAttachment    *connect.Client[v1.GetAttachmentRequest, v1.GetAttachmentResponse]
+	deleteAttachment *connect.Client[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse]
+}
+
+// CreateAttachment calls proto.v1.AttachmentService.CreateAttachment.
+func (c *attachmentServiceClient) CreateAttachment(ctx context.Context, req *v1.CreateAttachmentRequest) (*v1.CreateAttachmentResponse, error) {
+	response, err := c.createAttachment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAttachments calls proto.v1.AttachmentService.ListAttachments.
+func (c *attachmentServiceClient) ListAttachments(ctx context.Context, req *v1.ListAttachmentsRequest) (*v1.ListAttachmentsResponse, error) {
+	response, err := c.listAttachments.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAttachment calls proto.v1.AttachmentService.GetAttachment.
+func (c *attachmentServiceClient) GetAttachment(ctx context.Context, req *v1.GetAttachmentRequest) (*v1.GetAttachmentResponse, error) {
+	response, err := c.getAttachment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAttachment calls proto.v1.AttachmentService.DeleteAttachment.
+func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, req *v1.DeleteAttachmentRequest) (*v1.DeleteAttachmentResponse, error) {
+	response, err := c.deleteAttachment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AttachmentServiceHandler is an implementation of the proto.v1.AttachmentService service.
+type AttachmentServiceHandler interface {
+	// Add an attachment to a task and return the URL to upload its contents
+	// to. Requires the editor role for project tasks.
+	CreateAttachment(context.Context, *v1.CreateAttachmentRequest) (*v1.CreateAttachmentResponse, error)
+	// List the attachments of a task, oldest first.
+	ListAttachments(context.Context, *v1.ListAttachmentsRequest) (*v1.ListAttachmentsResponse, error)
+	// Get an attachment with the URL to download its contents from.
+	GetAttachment(context.Context, *v1.GetAttachmentRequest) (*v1.GetAttachmentResponse, error)
+	// Delete an attachment and its contents. Requires the editor role for
+	// project tasks.
+	DeleteAttachment(context.Context, *v1.DeleteAttachmentRequest) (*v1.DeleteAttachmentResponse, error)
+}
+
+// NewAttachmentServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAttachmentServiceHandler(svc AttachmentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	attachmentServiceMethods := v1.File_proto_v1_attachment_proto.Services().ByName("AttachmentService").Methods()
+	attachmentServiceCreateAttachmentHandler := connect.NewUnaryHandlerSimple(
+		AttachmentServiceCreateAttachmentProcedure,
+		svc.CreateAttachment,
+		connect.WithSchema(attachmentServiceMethods.ByName("CreateAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceListAttachmentsHandler := connect.NewUnaryHandlerSimple(
+		AttachmentServiceListAttachmentsProcedure,
+		svc.ListAttachments,
+		connect.WithSchema(attachmentServiceMethods.ByName("ListAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceGetAttachmentHandler := connect.NewUnaryHandlerSimple(
+		AttachmentServiceGetAttachmentProcedure,
+		svc.GetAttachment,
+		connect.WithSchema(attachmentServiceMethods.ByName("GetAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceDeleteAttachmentHandler := connect.NewUnaryHandlerSimple(
+		AttachmentServiceDeleteAttachmentProcedure,
+		svc.DeleteAttachment,
+		connect.WithSchema(attachmentServiceMethods.ByName("DeleteAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.v1.AttachmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AttachmentServiceCreateAttachmentProcedure:
+			attachmentServiceCreateAttachmentHandler.ServeHTTP(w, r)
+		case AttachmentServiceListAttachmentsProcedure:
+			attachmentServiceListAttachmentsHandler.ServeHTTP(w, r)
+		case AttachmentServiceGetAttachmentProcedure:
+			attachmentServiceGetAttachmentHandler.ServeHTTP(w, r)
+		case AttachmentServiceDeleteAttachmentProcedure:
+			attachmentServiceDeleteAttachmentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAttachmentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAttachmentServiceHandler struct{}
+
+func (UnimplementedAttachmentServiceHandler) CreateAttachment(context.Context, *v1.CreateAttachmentRequest) (*v1.CreateAttachmentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AttachmentService.CreateAttachment is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) ListAttachments(context.Context, *v1.ListAttachmentsRequest) (*v1.ListAttachmentsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AttachmentService.ListAttachments is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) GetAttachment(context.Context, *v1.GetAttachmentRequest) (*v1.GetAttachmentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AttachmentService.GetAttachment is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) DeleteAttachment(context.Context, *v1.DeleteAttachmentRequest) (*v1.DeleteAttachmentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AttachmentService.DeleteAttachment is not implemented"))
+}
@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/v1/auth.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sikigasa/task-controller/proto/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuthServiceName is the fully-qualified name of the AuthService service.
+	AuthServiceName = "proto.v1.AuthService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuthServiceSignupProcedure is the fully-qualified name of the AuthService's Signup RPC.
+	AuthServiceSignupProcedure = "/proto.v1.AuthService/Signup"
+	// AuthServiceLoginProcedure is the fully-qualified name of the AuthService's Login RPC.
+	AuthServiceLoginProcedure = "/proto.v1.AuthService/Login"
+	// AuthServiceCreateAPIKeyProcedure is the fully-qualified name of the AuthService's CreateAPIKey
+	// RPC.
+	AuthServiceCreateAPIKeyProcedure = "/proto.v1.AuthService/CreateAPIKey"
+	// AuthServiceListAPIKeyProcedure is the fully-qualified name of the AuthService's ListAPIKey RPC.
+	AuthServiceListAPIKeyProcedure = "/proto.v1.AuthService/ListAPIKey"
+	// AuthServiceDeleteAPIKeyProcedure is the fully-qualified name of the AuthService's DeleteAPIKey
+	// RPC.
+	AuthServiceDeleteAPIKeyProcedure = "/proto.v1.AuthService/DeleteAPIKey"
+)
+
+// AuthServiceClient is a client for the proto.v1.AuthService service.
+type AuthServiceClient interface {
+	// Create a user and log in.
+	Signup(context.Context, *v1.SignupRequest) (*v1.SignupResponse, error)
+	// Exchange an email and password for an access token.
+	Login(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
+	// Create an API key for the current user. The key is only returned here.
+	CreateAPIKey(context.Context, *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error)
+	// List the API keys of the current user.
+	ListAPIKey(context.Context, *v1.ListAPIKeyRequest) (*v1.ListAPIKeyResponse, error)
+	// Revoke an API key of the current user.
+	DeleteAPIKey(context.Context, *v1.DeleteAPIKeyRequest) (*v1.DeleteAPIKeyResponse, error)
+}
+
+// NewAuthServiceClient constructs a client for the proto.v1.AuthService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuthServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	authServiceMethods := v1.File_proto_v1_auth_proto.Services().ByName("AuthService").Methods()
+	return &authServiceClient{
+		signup: connect.NewClient[v1.SignupRequest, v1.SignupResponse](
+			httpClient,
+			baseURL+AuthServiceSignupProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Signup")),
+			connect.WithClientOptions(opts...),
+		),
+		login: connect.NewClient[v1.LoginRequest, v1.LoginResponse](
+			httpClient,
+			baseURL+AuthServiceLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Login")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+AuthServiceCreateAPIKeyProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKey: connect.NewClient[v1.ListAPIKeyRequest, v1.ListAPIKeyResponse](
+			httpClient,
+			baseURL+AuthServiceListAPIKeyProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAPIKey: connect.NewClient[v1.DeleteAPIKeyRequest, v1.DeleteAPIKeyResponse](
+			httpClient,
+			baseURL+AuthServiceDeleteAPIKeyProcedure,
+			connect.WithSchema(authServiceMethods.ByName("DeleteAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// authServiceClient implements AuthServiceClient.
+type authServiceClient struct {
+	signup       *connect.Client[v1.SignupRequest, v1.SignupResponse]
+	login        *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	createAPIKey *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	listAPIKey   *connect.Client[v1.ListAPIKeyRequest, v1.ListAPIKeyResponse]
+	deleteAPIKey *connect.Client[v1.DeleteAPIKeyRequest, v1.DeleteAPIKeyResponse]
+}
+
+// Signup calls proto.v1.AuthService.Signup.
+func (c *authServiceClient) Signup(ctx context.Context, req *v1.SignupRequest) (*v1.SignupResponse, error) {
+	response, err := c.signup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Login calls proto.v1.AuthService.Login.
+func (c *authServiceClient) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginResponse, error) {
+	response, err := c.login.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAPIKey calls proto.v1.AuthService.CreateAPIKey.
+func (c *authServiceClient) CreateAPIKey(ctx context.Context, req *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	response, err := c.createAPIKey.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAPIKey calls proto.v1.AuthService.ListAPIKey.
+func (c *authServiceClient) ListAPIKey(ctx context.Context, req *v1.ListAPIKeyRequest) (*v1.ListAPIKeyResponse, error) {
+	response, err := c.listAPIKey.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAPIKey calls proto.v1.AuthService.DeleteAPIKey.
+func (c *authServiceClient) DeleteAPIKey(ctx context.Context, req *v1.DeleteAPIKeyRequest) (*v1.DeleteAPIKeyResponse, error) {
+	response, err := c.deleteAPIKey.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AuthServiceHandler is an implementation of the proto.v1.AuthService service.
+type AuthServiceHandler interface {
+	// Create a user and log in.
+	Signup(context.Context, *v1.SignupRequest) (*v1.SignupResponse, error)
+	// Exchange an email and password for an access token.
+	Login(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
+	// Create an API key for the current user. The key is only returned here.
+	CreateAPIKey(context.Context, *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error)
+	// List the API keys of the current user.
+	ListAPIKey(context.Context, *v1.ListAPIKeyRequest) (*v1.ListAPIKeyResponse, error)
+	// Revoke an API key of the current user.
+	DeleteAPIKey(context.Context, *v1.DeleteAPIKeyRequest) (*v1.DeleteAPIKeyResponse, error)
+}
+
+// NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	authServiceMethods := v1.File_proto_v1_auth_proto.Services().ByName("AuthService").Methods()
+	authServiceSignupHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceSignupProcedure,
+		svc.Signup,
+		connect.WithSchema(authServiceMethods.ByName("Signup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceLoginHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceLoginProcedure,
+		svc.Login,
+		connect.WithSchema(authServiceMethods.ByName("Login")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCreateAPIKeyHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(authServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListAPIKeyHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceListAPIKeyProcedure,
+		svc.ListAPIKey,
+		connect.WithSchema(authServiceMethods.ByName("ListAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceDeleteAPIKeyHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceDeleteAPIKeyProcedure,
+		svc.DeleteAPIKey,
+		connect.WithSchema(authServiceMethods.ByName("DeleteAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuthServiceSignupProcedure:
+			authServiceSignupHandler.ServeHTTP(w, r)
+		case AuthServiceLoginProcedure:
+			authServiceLoginHandler.ServeHTTP(w, r)
+		case AuthServiceCreateAPIKeyProcedure:
+			authServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case AuthServiceListAPIKeyProcedure:
+			authServiceListAPIKeyHandler.ServeHTTP(w, r)
+		case AuthServiceDeleteAPIKeyProcedure:
+			authServiceDeleteAPIKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuthServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuthServiceHandler struct{}
+
+func (UnimplementedAuthServiceHandler) Signup(context.Context, *v1.SignupRequest) (*v1.SignupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AuthService.Signup is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Login(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AuthService.Login is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CreateAPIKey(context.Context, *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AuthService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListAPIKey(context.Context, *v1.ListAPIKeyRequest) (*v1.ListAPIKeyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AuthService.ListAPIKey is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) DeleteAPIKey(context.Context, *v1.DeleteAPIKeyRequest) (*v1.DeleteAPIKeyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.AuthService.DeleteAPIKey is not implemented"))
+}
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/v1/comment.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sikigasa/task-controller/proto/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CommentServiceName is the fully-qualified name of the CommentService service.
+	CommentServiceName = "proto.v1.CommentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CommentServiceAddCommentProcedure is the fully-qualified name of the CommentService's AddComment
+	// RPC.
+	CommentServiceAddCommentProcedure = "/proto.v1.CommentService/AddComment"
+	// CommentServiceListCommentsProcedure is the fully-qualified name of the CommentService's
+	// ListComments RPC.
+	CommentServiceListCommentsProcedure = "/proto.v1.CommentService/ListComments"
+	// CommentServiceEditCommentProcedure is the fully-qualified name of the CommentService's
+	// EditComment RPC.
+	CommentServiceEditCommentProcedure = "/proto.v1.CommentService/EditComment"
+	// CommentServiceDeleteCommentProcedure is the fully-qualified name of the CommentService's
+	// DeleteComment RPC.
+	CommentServiceDeleteCommentProcedure = "/proto.v1.CommentService/DeleteComment"
+)
+
+// CommentServiceClient is a client for the proto.v1.CommentService service.
+type CommentServiceClient interface {
+	// Add a comment to a task as the current user.
+	AddComment(context.Context, *v1.AddCommentRequest) (*v1.AddCommentResponse, error)
+	// List the comments of a task, oldest first.
+	ListComments(context.Context, *v1.ListCommentsRequest) (*v1.ListCommentsResponse, error)
+	// Replace the body of a comment. The previous body is kept in its history.
+	// Only the author may edit a comment.
+	EditComment(context.Context, *v1.EditCommentRequest) (*v1.EditCommentResponse, error)
+	// Delete a comment. The author may delete their comments, and the owner of
+	// a project any comment on its tasks.
+	DeleteComment(context.Context, *v1.DeleteCommentRequest) (*v1.DeleteCommentResponse, error)
+}
+
+// NewCommentServiceClient constructs a client for the proto.v1.CommentService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCommentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CommentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	commentServiceMethods := v1.File_proto_v1_comment_proto.Services().ByName("CommentService").Methods()
+	return &commentServiceClient{
+		addComment: connect.NewClient[v1.AddCommentRequest, v1.AddCommentResponse](
+			httpClient,
+			baseURL+CommentServiceAddCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("AddComment")),
+			connect.WithClientOptions(opts...),
+		),
+		listComments: connect.NewClient[v1.ListCommentsRequest, v1.ListCommentsResponse](
+			httpClient,
+			baseURL+CommentServiceListCommentsProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+			connect.WithClientOptions(opts...),
+		),
+		editComment: connect.NewClient[v1.EditCommentRequest, v1.EditCommentResponse](
+			httpClient,
+			baseURL+CommentServiceEditCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("EditComment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteComment: connect.NewClient[v1.DeleteCommentRequest, v1.DeleteCommentResponse](
+			httpClient,
+			baseURL+CommentServiceDeleteCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// commentServiceClient implements CommentServiceClient.
+type commentServiceClient struct {
+	addComment    *connect.Client[v1.AddCommentRequest, v1.AddCommentResponse]
+	listComments  *connect.Client[v1.ListCommentsRequest, v1.ListCommentsResponse]
+	editComment   *connect.Client[v1.EditCommentRequest, v1.EditCommentResponse]
+	deleteComment *connect.Client[v1.DeleteCommentRequest, v1.DeleteCommentResponse]
+}
+
+// AddComment calls proto.v1.CommentService.AddComment.
+func (c *commentServiceClient) AddComment(ctx context.Context, req *v1.AddCommentRequest) (*v1.AddCommentResponse, error) {
+	response, err := c.addComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListComments calls proto.v1.CommentService.ListComments.
+func (c *commentServiceClient) ListComments(ctx context.Context, req *v1.ListCommentsRequest) (*v1.ListCommentsResponse, error) {
+	response, err := c.listComments.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EditComment calls proto.v1.CommentService.EditComment.
+func (c *commentServiceClient) EditComment(ctx context.Context, req *v1.EditCommentRequest) (*v1.EditCommentResponse, error) {
+	response, err := c.editComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteComment calls proto.v1.CommentService.DeleteComment.
+func (c *commentServiceClient) DeleteComment(ctx context.Context, req *v1.DeleteCommentRequest) (*v1.DeleteCommentResponse, error) {
+	response, err := c.deleteComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CommentServiceHandler is an implementation of the proto.v1.CommentService service.
+type CommentServiceHandler interface {
+	// Add a comment to a task as the current user.
+	AddComment(context.Context, *v1.AddCommentRequest) (*v1.AddCommentResponse, error)
+	// List the comments of a task, oldest first.
+	ListComments(context.Context, *v1.ListCommentsRequest) (*v1.ListCommentsResponse, error)
+	// Replace the body of a comment. The previous body is kept in its history.
+	// Only the author may edit a comment.
+	EditComment(context.Context, *v1.EditCommentRequest) (*v1.EditCommentResponse, error)
+	// Delete a comment. The author may delete their comments, and the owner of
+	// a project any comment on its tasks.
+	DeleteComment(context.Context, *v1.DeleteCommentRequest) (*v1.DeleteCommentResponse, error)
+}
+
+// NewCommentServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCommentServiceHandler(svc CommentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	commentServiceMethods := v1.File_proto_v1_comment_proto.Services().ByName("CommentService").Methods()
+	commentServiceAddCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceAddCommentProcedure,
+		svc.AddComment,
+		connect.WithSchema(commentServiceMethods.ByName("AddComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceListCommentsHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceListCommentsProcedure,
+		svc.ListComments,
+		connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceEditCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceEditCommentProcedure,
+		svc.EditComment,
+		connect.WithSchema(commentServiceMethods.ByName("EditComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceDeleteCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentServiceDeleteCommentProcedure,
+		svc.DeleteComment,
+		connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.v1.CommentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CommentServiceAddCommentProcedure:
+			commentServiceAddCommentHandler.ServeHTTP(w, r)
+		case CommentServiceListCommentsProcedure:
+			commentServiceListCommentsHandler.ServeHTTP(w, r)
+		case CommentServiceEditCommentProcedure:
+			commentServiceEditCommentHandler.ServeHTTP(w, r)
+		case CommentServiceDeleteCommentProcedure:
+			commentServiceDeleteCommentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCommentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCommentServiceHandler struct{}
+
+func (UnimplementedCommentServiceHandler) AddComment(context.Context, *v1.AddCommentRequest) (*v1.AddCommentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.CommentService.AddComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) ListComments(context.Context, *v1.ListCommentsRequest) (*v1.ListCommentsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.CommentService.ListComments is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) EditComment(context.Context, *v1.EditCommentRequest) (*v1.EditCommentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.CommentService.EditComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) DeleteComment(context.Context, *v1.DeleteCommentRequest) (*v1.DeleteCommentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.CommentService.DeleteComment is not implemented"))
+}
@@ -0,0 +1,481 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/v1/project.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sikigasa/task-controller/proto/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProjectServiceName is the fully-qualified name of the ProjectService service.
+	ProjectServiceName = "proto.v1.ProjectService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProjectServiceCreateProjectProcedure is the fully-qualified name of the ProjectService's
+	// CreateProject RPC.
+	ProjectServiceCreateProjectProcedure = "/proto.v1.ProjectService/CreateProject"
+	// ProjectServiceListProjectProcedure is the fully-qualified name of the ProjectService's
+	// ListProject RPC.
+	ProjectServiceListProjectProcedure = "/proto.v1.ProjectService/ListProject"
+	// ProjectServiceRenameProjectProcedure is the fully-qualified name of the ProjectService's
+	// RenameProject RPC.
+	ProjectServiceRenameProjectProcedure = "/proto.v1.ProjectService/RenameProject"
+	// ProjectServiceArchiveProjectProcedure is the fully-qualified name of the ProjectService's
+	// ArchiveProject RPC.
+	ProjectServiceArchiveProjectProcedure = "/proto.v1.ProjectService/ArchiveProject"
+	// ProjectServiceListProjectMemberProcedure is the fully-qualified name of the ProjectService's
+	// ListProjectMember RPC.
+	ProjectServiceListProjectMemberProcedure = "/proto.v1.ProjectService/ListProjectMember"
+	// ProjectServiceInviteMemberProcedure is the fully-qualified name of the ProjectService's
+	// InviteMember RPC.
+	ProjectServiceInviteMemberProcedure = "/proto.v1.ProjectService/InviteMember"
+	// ProjectServiceListInvitationProcedure is the fully-qualified name of the ProjectService's
+	// ListInvitation RPC.
+	ProjectServiceListInvitationProcedure = "/proto.v1.ProjectService/ListInvitation"
+	// ProjectServiceAcceptInvitationProcedure is the fully-qualified name of the ProjectService's
+	// AcceptInvitation RPC.
+	ProjectServiceAcceptInvitationProcedure = "/proto.v1.ProjectService/AcceptInvitation"
+	// ProjectServiceRevokeMemberProcedure is the fully-qualified name of the ProjectService's
+	// RevokeMember RPC.
+	ProjectServiceRevokeMemberProcedure = "/proto.v1.ProjectService/RevokeMember"
+	// ProjectServiceGetWorkflowProcedure is the fully-qualified name of the ProjectService's
+	// GetWorkflow RPC.
+	ProjectServiceGetWorkflowProcedure = "/proto.v1.ProjectService/GetWorkflow"
+	// ProjectServiceUpdateWorkflowProcedure is the fully-qualified name of the ProjectService's
+	// UpdateWorkflow RPC.
+	ProjectServiceUpdateWorkflowProcedure = "/proto.v1.ProjectService/UpdateWorkflow"
+)
+
+// ProjectServiceClient is a client for the proto.v1.ProjectService service.
+type ProjectServiceClient interface {
+	// Create a project with the current user as its first member.
+	CreateProject(context.Context, *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error)
+	// List the projects the current user is a member of.
+	ListProject(context.Context, *v1.ListProjectRequest) (*v1.ListProjectResponse, error)
+	// Rename a project. Requires the owner role.
+	RenameProject(context.Context, *v1.RenameProjectRequest) (*v1.RenameProjectResponse, error)
+	// Archive a project. Archived projects are hidden from ListProject by
+	// default and no new tasks can be added to them. Requires the owner role.
+	ArchiveProject(context.Context, *v1.ArchiveProjectRequest) (*v1.ArchiveProjectResponse, error)
+	// List the members of a project.
+	ListProjectMember(context.Context, *v1.ListProjectMemberRequest) (*v1.ListProjectMemberResponse, error)
+	// Invite a user to a project. They become a member once they accept the
+	// invitation. Requires the owner role.
+	InviteMember(context.Context, *v1.InviteMemberRequest) (*v1.InviteMemberResponse, error)
+	// List the pending invitations addressed to the current user.
+	ListInvitation(context.Context, *v1.ListInvitationRequest) (*v1.ListInvitationResponse, error)
+	// Accept an invitation addressed to the current user.
+	AcceptInvitation(context.Context, *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error)
+	// Remove a member from a project, or withdraw their pending invitation.
+	// The owner cannot be removed. Requires the owner role.
+	RevokeMember(context.Context, *v1.RevokeMemberRequest) (*v1.RevokeMemberResponse, error)
+	// Read the statuses and transitions tasks of a project follow.
+	GetWorkflow(context.Context, *v1.GetWorkflowRequest) (*v1.GetWorkflowResponse, error)
+	// Replace the workflow of a project. Statuses are matched by name, so tasks
+	// keep their status; removing a status that tasks are in fails with
+	// FAILED_PRECONDITION. Tasks in a status that becomes an end status are
+	// completed and recurring tasks get their next occurrence, even if they
+	// are blocked or have open subtasks. Tasks in the trash follow when they
+	// are restored. Requires the owner role.
+	UpdateWorkflow(context.Context, *v1.UpdateWorkflowRequest) (*v1.UpdateWorkflowResponse, error)
+}
+
+// NewProjectServiceClient constructs a client for the proto.v1.ProjectService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProjectServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	projectServiceMethods := v1.File_proto_v1_project_proto.Services().ByName("ProjectService").Methods()
+	return &projectServiceClient{
+		createProject: connect.NewClient[v1.CreateProjectRequest, v1.CreateProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceCreateProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("CreateProject")),
+			connect.WithClientOptions(opts...),
+		),
+		listProject: connect.NewClient[v1.ListProjectRequest, v1.ListProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceListProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListProject")),
+			connect.WithClientOptions(opts...),
+		),
+		renameProject: connect.NewClient[v1.RenameProjectRequest, v1.RenameProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceRenameProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RenameProject")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveProject: connect.NewClient[v1.ArchiveProjectRequest, v1.ArchiveProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceArchiveProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ArchiveProject")),
+			connect.WithClientOptions(opts...),
+		),
+		listProjectMember: connect.NewClient[v1.ListProjectMemberRequest, v1.ListProjectMemberResponse](
+			httpClient,
+			baseURL+ProjectServiceListProjectMemberProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListProjectMember")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteMember: connect.NewClient[v1.InviteMemberRequest, v1.InviteMemberResponse](
+			httpClient,
+			baseURL+ProjectServiceInviteMemberProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("InviteMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvitation: connect.NewClient[v1.ListInvitationRequest, v1.ListInvitationResponse](
+			httpClient,
+			baseURL+ProjectServiceListInvitationProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse](
+			httpClient,
+			baseURL+ProjectServiceAcceptInvitationProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("AcceptInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeMember: connect.NewClient[v1.RevokeMemberRequest, v1.RevokeMemberResponse](
+			httpClient,
+			baseURL+ProjectServiceRevokeMemberProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RevokeMember")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkflow: connect.NewClient[v1.GetWorkflowRequest, v1.GetWorkflowResponse](
+			httpClient,
+			baseURL+ProjectServiceGetWorkflowProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("GetWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWorkflow: connect.NewClient[v1.UpdateWorkflowRequest, v1.UpdateWorkflowResponse](
+			httpClient,
+			baseURL+ProjectServiceUpdateWorkflowProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("UpdateWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// projectServiceClient implements ProjectServiceClient.
+type projectServiceClient struct {
+	createProject     *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
+	listProject       *connect.Client[v1.ListProjectRequest, v1.ListProjectResponse]
+	renameProject     *connect.Client[v1.RenameProjectRequest, v1.RenameProjectResponse]
+	archiveProject    *connect.Client[v1.ArchiveProjectRequest, v1.ArchiveProjectResponse]
+	listProjectMember *connect.Client[v1.ListProjectMemberRequest, v1.ListProjectMemberResponse]
+	inviteMember      *connect.Client[v1.InviteMemberRequest, v1.InviteMemberResponse]
+	listInvitation    *connect.Client[v1.ListInvitationRequest, v1.ListInvitationResponse]
+	acceptInvitation  *connect.Client[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse]
+	revokeMember      *connect.Client[v1.RevokeMemberRequest, v1.RevokeMemberResponse]
+	getWorkflow       *connect.Client[v1.GetWorkflowRequest, v1.GetWorkflowResponse]
+	updateWorkflow    *connect.Client[v1.UpdateWorkflowRequest, v1.UpdateWorkflowResponse]
+}
+
+// CreateProject calls proto.v1.ProjectService.CreateProject.
+func (c *projectServiceClient) CreateProject(ctx context.Context, req *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error) {
+	response, err := c.createProject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListProject calls proto.v1.ProjectService.ListProject.
+func (c *projectServiceClient) ListProject(ctx context.Context, req *v1.ListProjectRequest) (*v1.ListProjectResponse, error) {
+	response, err := c.listProject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RenameProject calls proto.v1.ProjectService.RenameProject.
+func (c *projectServiceClient) RenameProject(ctx context.Context, req *v1.RenameProjectRequest) (*v1.RenameProjectResponse, error) {
+	response, err := c.renameProject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ArchiveProject calls proto.v1.ProjectService.ArchiveProject.
+func (c *projectServiceClient) ArchiveProject(ctx context.Context, req *v1.ArchiveProjectRequest) (*v1.ArchiveProjectResponse, error) {
+	response, err := c.archiveProject.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListProjectMember calls proto.v1.ProjectService.ListProjectMember.
+func (c *projectServiceClient) ListProjectMember(ctx context.Context, req *v1.ListProjectMemberRequest) (*v1.ListProjectMemberResponse, error) {
+	response, err := c.listProjectMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// InviteMember calls proto.v1.ProjectService.InviteMember.
+func (c *projectServiceClient) InviteMember(ctx context.Context, req *v1.InviteMemberRequest) (*v1.InviteMemberResponse, error) {
+	response, err := c.inviteMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListInvitation calls proto.v1.ProjectService.ListInvitation.
+func (c *projectServiceClient) ListInvitation(ctx context.Context, req *v1.ListInvitationRequest) (*v1.ListInvitationResponse, error) {
+	response, err := c.listInvitation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AcceptInvitation calls proto.v1.ProjectService.AcceptInvitation.
+func (c *projectServiceClient) AcceptInvitation(ctx context.Context, req *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error) {
+	response, err := c.acceptInvitation.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeMember calls proto.v1.ProjectService.RevokeMember.
+func (c *projectServiceClient) RevokeMember(ctx context.Context, req *v1.RevokeMemberRequest) (*v1.RevokeMemberResponse, error) {
+	response, err := c.revokeMember.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetWorkflow calls proto.v1.ProjectService.GetWorkflow.
+func (c *projectServiceClient) GetWorkflow(ctx context.Context, req *v1.GetWorkflowRequest) (*v1.GetWorkflowResponse, error) {
+	response, err := c.getWorkflow.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateWorkflow calls proto.v1.ProjectService.UpdateWorkflow.
+func (c *projectServiceClient) UpdateWorkflow(ctx context.Context, req *v1.UpdateWorkflowRequest) (*v1.UpdateWorkflowResponse, error) {
+	response, err := c.updateWorkflow.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ProjectServiceHandler is an implementation of the proto.v1.ProjectService service.
+type ProjectServiceHandler interface {
+	// Create a project with the current user as its first member.
+	CreateProject(context.Context, *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error)
+	// List the projects the current user is a member of.
+	ListProject(context.Context, *v1.ListProjectRequest) (*v1.ListProjectResponse, error)
+	// Rename a project. Requires the owner role.
+	RenameProject(context.Context, *v1.RenameProjectRequest) (*v1.RenameProjectResponse, error)
+	// Archive a project. Archived projects are hidden from ListProject by
+	// default and no new tasks can be added to them. Requires the owner role.
+	ArchiveProject(context.Context, *v1.ArchiveProjectRequest) (*v1.ArchiveProjectResponse, error)
+	// List the members of a project.
+	ListProjectMember(context.Context, *v1.ListProjectMemberRequest) (*v1.ListProjectMemberResponse, error)
+	// Invite a user to a project. They become a member once they accept the
+	// invitation. Requires the owner role.
+	InviteMember(context.Context, *v1.InviteMemberRequest) (*v1.InviteMemberResponse, error)
+	// List the pending invitations addressed to the current user.
+	ListInvitation(context.Context, *v1.ListInvitationRequest) (*v1.ListInvitationResponse, error)
+	// Accept an invitation addressed to the current user.
+	AcceptInvitation(context.Context, *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error)
+	// Remove a member from a project, or withdraw their pending invitation.
+	// The owner cannot be removed. Requires the owner role.
+	RevokeMember(context.Context, *v1.RevokeMemberRequest) (*v1.RevokeMemberResponse, error)
+	// Read the statuses and transitions tasks of a project follow.
+	GetWorkflow(context.Context, *v1.GetWorkflowRequest) (*v1.GetWorkflowResponse, error)
+	// Replace the workflow of a project. Statuses are matched by name, so tasks
+	// keep their status; removing a status that tasks are in fails with
+	// FAILED_PRECONDITION. Tasks in a status that becomes an end status are
+	// completed and recurring tasks get their next occurrence, even if they
+	// are blocked or have open subtasks. Tasks in the trash follow when they
+	// are restored. Requires the owner role.
+	UpdateWorkflow(context.Context, *v1.UpdateWorkflowRequest) (*v1.UpdateWorkflowResponse, error)
+}
+
+// NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	projectServiceMethods := v1.File_proto_v1_project_proto.Services().ByName("ProjectService").Methods()
+	projectServiceCreateProjectHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceCreateProjectProcedure,
+		svc.CreateProject,
+		connect.WithSchema(projectServiceMethods.ByName("CreateProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListProjectHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceListProjectProcedure,
+		svc.ListProject,
+		connect.WithSchema(projectServiceMethods.ByName("ListProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRenameProjectHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceRenameProjectProcedure,
+		svc.RenameProject,
+		connect.WithSchema(projectServiceMethods.ByName("RenameProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceArchiveProjectHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceArchiveProjectProcedure,
+		svc.ArchiveProject,
+		connect.WithSchema(projectServiceMethods.ByName("ArchiveProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListProjectMemberHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceListProjectMemberProcedure,
+		svc.ListProjectMember,
+		connect.WithSchema(projectServiceMethods.ByName("ListProjectMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceInviteMemberHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceInviteMemberProcedure,
+		svc.InviteMember,
+		connect.WithSchema(projectServiceMethods.ByName("InviteMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListInvitationHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceListInvitationProcedure,
+		svc.ListInvitation,
+		connect.WithSchema(projectServiceMethods.ByName("ListInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceAcceptInvitationHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(projectServiceMethods.ByName("AcceptInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRevokeMemberHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceRevokeMemberProcedure,
+		svc.RevokeMember,
+		connect.WithSchema(projectServiceMethods.ByName("RevokeMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceGetWorkflowHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceGetWorkflowProcedure,
+		svc.GetWorkflow,
+		connect.WithSchema(projectServiceMethods.ByName("GetWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceUpdateWorkflowHandler := connect.NewUnaryHandlerSimple(
+		ProjectServiceUpdateWorkflowProcedure,
+		svc.UpdateWorkflow,
+		connect.WithSchema(projectServiceMethods.ByName("UpdateWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProjectServiceCreateProjectProcedure:
+			projectServiceCreateProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceListProjectProcedure:
+			projectServiceListProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceRenameProjectProcedure:
+			projectServiceRenameProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceArchiveProjectProcedure:
+			projectServiceArchiveProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceListProjectMemberProcedure:
+			projectServiceListProjectMemberHandler.ServeHTTP(w, r)
+		case ProjectServiceInviteMemberProcedure:
+			projectServiceInviteMemberHandler.ServeHTTP(w, r)
+		case ProjectServiceListInvitationProcedure:
+			projectServiceListInvitationHandler.ServeHTTP(w, r)
+		case ProjectServiceAcceptInvitationProcedure:
+			projectServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case ProjectServiceRevokeMemberProcedure:
+			projectServiceRevokeMemberHandler.ServeHTTP(w, r)
+		case ProjectServiceGetWorkflowProcedure:
+			projectServiceGetWorkflowHandler.ServeHTTP(w, r)
+		case ProjectServiceUpdateWorkflowProcedure:
+			projectServiceUpdateWorkflowHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProjectServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProjectServiceHandler struct{}
+
+func (UnimplementedProjectServiceHandler) CreateProject(context.Context, *v1.CreateProjectRequest) (*v1.CreateProjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.CreateProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListProject(context.Context, *v1.ListProjectRequest) (*v1.ListProjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.ListProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RenameProject(context.Context, *v1.RenameProjectRequest) (*v1.RenameProjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.RenameProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ArchiveProject(context.Context, *v1.ArchiveProjectRequest) (*v1.ArchiveProjectResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.ArchiveProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListProjectMember(context.Context, *v1.ListProjectMemberRequest) (*v1.ListProjectMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.ListProjectMember is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) InviteMember(context.Context, *v1.InviteMemberRequest) (*v1.InviteMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.InviteMember is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListInvitation(context.Context, *v1.ListInvitationRequest) (*v1.ListInvitationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.ListInvitation is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) AcceptInvitation(context.Context, *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RevokeMember(context.Context, *v1.RevokeMemberRequest) (*v1.RevokeMemberResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.RevokeMember is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) GetWorkflow(context.Context, *v1.GetWorkflowRequest) (*v1.GetWorkflowResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.GetWorkflow is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) UpdateWorkflow(context.Context, *v1.UpdateWorkflowRequest) (*v1.UpdateWorkflowResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.ProjectService.UpdateWorkflow is not implemented"))
+}